@@ -0,0 +1,52 @@
+package survey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// accessibleChoice looks up the option the user picked in accessible mode. An option
+// can be referred to by its position in the printed list (starting at 1) or by its
+// name, ignoring case.
+func accessibleChoice(options []string, val string) (string, error) {
+	val = strings.TrimSpace(val)
+
+	// if the user typed a number
+	if n, err := strconv.Atoi(val); err == nil {
+		// make sure it points to one of the options
+		if n < 1 || n > len(options) {
+			return "", fmt.Errorf("%v is not between 1 and %v, please try again.", n, len(options))
+		}
+		return options[n-1], nil
+	}
+
+	// otherwise look for an option with the same name
+	for _, opt := range options {
+		if strings.EqualFold(opt, val) {
+			return opt, nil
+		}
+	}
+
+	// we didn't find the option the user was looking for
+	return "", fmt.Errorf("%q is not one of the options, please try again.", val)
+}
+
+// accessibleChoices looks up every option in a comma separated list of numbers or names.
+func accessibleChoices(options []string, val string) ([]string, error) {
+	answers := []string{}
+	for _, part := range strings.Split(val, ",") {
+		// ignore empty entries so a trailing comma is not an error
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+
+		choice, err := accessibleChoice(options, part)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, choice)
+	}
+
+	return answers, nil
+}
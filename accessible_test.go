@@ -0,0 +1,42 @@
+package survey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibleChoice(t *testing.T) {
+	options := []string{"red", "blue", "green"}
+
+	tests := []struct {
+		title    string
+		input    string
+		expected string
+		valid    bool
+	}{
+		{"picks an option by number", "2", "blue", true},
+		{"picks an option by name", "green", "green", true},
+		{"ignores case and spaces", " RED ", "red", true},
+		{"rejects a number that is too small", "0", "", false},
+		{"rejects a number that is too large", "4", "", false},
+		{"rejects unknown names", "purple", "", false},
+	}
+
+	for _, test := range tests {
+		choice, err := accessibleChoice(options, test.input)
+		assert.Equal(t, test.valid, err == nil, test.title)
+		assert.Equal(t, test.expected, choice, test.title)
+	}
+}
+
+func TestAccessibleChoices(t *testing.T) {
+	options := []string{"red", "blue", "green"}
+
+	choices, err := accessibleChoices(options, "1, green,")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"red", "green"}, choices)
+
+	_, err = accessibleChoices(options, "1, purple")
+	assert.NotNil(t, err)
+}
@@ -1,9 +1,11 @@
 package survey
 
 import (
+	"errors"
 	"fmt"
-	"os"
 	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
@@ -14,7 +16,17 @@ type Confirm struct {
 	core.Renderer
 	Message string
 	Default bool
-	Help    string
+	// NoDefault makes the user answer explicitly instead of taking Default on enter
+	NoDefault bool
+	// Instant answers as soon as y or n is pressed, without waiting for enter
+	Instant bool
+	// Skip is the label of a third answer, like "skip" or "cancel", which is given by
+	// typing it or its first letter and makes the prompt return SkipErr. Ask moves on to
+	// the next question without writing an answer. The label can't start with y or n.
+	Skip          string
+	Help          string
+	Template      string
+	TemplateFuncs map[string]interface{}
 }
 
 // data available to the templates when processing
@@ -33,7 +45,7 @@ var ConfirmQuestionTemplate = `
   {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
   {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
-  {{- color "white"}}{{if .Default}}(Y/n) {{else}}(y/N) {{end}}{{color "reset"}}
+  {{- color "white"}}({{if .NoDefault}}y/n{{else if .Default}}Y/n{{else}}y/N{{end}}{{with .Skip}}/{{.}}{{end}}) {{color "reset"}}
 {{- end}}`
 
 // the regex for answers
@@ -42,6 +54,9 @@ var (
 	noRx  = regexp.MustCompile("^(?i:n(?:o)?)$")
 )
 
+// SkipErr is returned by a Confirm answered with its Skip label.
+var SkipErr = errors.New("skipped")
+
 func yesNo(t bool) string {
 	if t {
 		return "Yes"
@@ -50,31 +65,23 @@ func yesNo(t bool) string {
 }
 
 func (c *Confirm) getBool(showHelp bool) (bool, error) {
-	rr := terminal.NewRuneReader(os.Stdin)
+	rr := c.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 	// start waiting for input
 	for {
-		line, err := rr.ReadLine(0)
+		val, err := c.readAnswer(rr)
 		if err != nil {
 			return false, err
 		}
-		// move back up a line to compensate for the \n echoed from terminal
-		terminal.CursorPreviousLine(1)
-		val := string(line)
 
 		// get the answer that matches the
-		var answer bool
+		answer, err := c.answer(val)
 		switch {
-		case yesRx.Match([]byte(val)):
-			answer = true
-		case noRx.Match([]byte(val)):
-			answer = false
-		case val == "":
-			answer = c.Default
-		case val == string(core.HelpInputRune) && c.Help != "":
-			err := c.Render(
-				ConfirmQuestionTemplate,
+		case err == SkipErr:
+			return c.Default, err
+		case val == string(c.Config().HelpInputRune) && c.Help != "":
+			err := c.render(
 				ConfirmTemplateData{Confirm: *c, ShowHelp: true},
 			)
 			if err != nil {
@@ -83,13 +90,12 @@ func (c *Confirm) getBool(showHelp bool) (bool, error) {
 			}
 			showHelp = true
 			continue
-		default:
+		case err != nil:
 			// we didnt get a valid answer, so print error and prompt again
-			if err := c.Error(fmt.Errorf("%q is not a valid answer, please try again.", val)); err != nil {
+			if err := c.Error(err); err != nil {
 				return c.Default, err
 			}
-			err := c.Render(
-				ConfirmQuestionTemplate,
+			err := c.render(
 				ConfirmTemplateData{Confirm: *c, ShowHelp: showHelp},
 			)
 			if err != nil {
@@ -104,6 +110,61 @@ func (c *Confirm) getBool(showHelp bool) (bool, error) {
 	return c.Default, nil
 }
 
+// readAnswer reads a line, or a single key in instant mode where enter is an empty answer
+func (c *Confirm) readAnswer(rr *terminal.RuneReader) (string, error) {
+	// accessible prompts are always answered with a line
+	if !c.Instant || c.Config().Accessible {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+		// move back up a line to compensate for the \n echoed from terminal
+		if !c.Config().Accessible {
+			c.NewCursor().PreviousLine(1)
+		}
+		return string(line), nil
+	}
+
+	r, _, err := rr.ReadRune()
+	if err != nil {
+		return "", err
+	}
+	switch r {
+	case terminal.KeyInterrupt:
+		return "", terminal.InterruptErr
+	case '\r', '\n', terminal.KeyEndTransmission:
+		return "", nil
+	}
+	return string(r), nil
+}
+
+// answer returns the answer matching what was typed, which is the default when nothing was
+// unless there is none
+func (c *Confirm) answer(val string) (bool, error) {
+	switch {
+	case yesRx.MatchString(val):
+		return true, nil
+	case noRx.MatchString(val):
+		return false, nil
+	case c.skipped(val):
+		return c.Default, SkipErr
+	case val == "" && c.NoDefault:
+		return c.Default, errors.New("an answer is required, please try again.")
+	case val == "":
+		return c.Default, nil
+	}
+	return c.Default, fmt.Errorf("%q is not a valid answer, please try again.", val)
+}
+
+// skipped returns true if the Skip label or its first letter was typed
+func (c *Confirm) skipped(val string) bool {
+	if c.Skip == "" || val == "" {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(c.Skip)
+	return strings.EqualFold(val, c.Skip) || strings.EqualFold(val, string(first))
+}
+
 /*
 Prompt prompts the user with a simple text field and expects a reply followed
 by a carriage return.
@@ -113,9 +174,13 @@ by a carriage return.
 	survey.AskOne(prompt, &likesPie, nil)
 */
 func (c *Confirm) Prompt() (interface{}, error) {
+	// the first letter of the Skip label would be taken for yes or no
+	if first, _ := utf8.DecodeRuneInString(c.Skip); yesRx.MatchString(string(first)) || noRx.MatchString(string(first)) {
+		return c.Default, fmt.Errorf("the Skip label %q can't start with y or n", c.Skip)
+	}
+
 	// render the question template
-	err := c.Render(
-		ConfirmQuestionTemplate,
+	err := c.render(
 		ConfirmTemplateData{Confirm: *c},
 	)
 	if err != nil {
@@ -123,7 +188,14 @@ func (c *Confirm) Prompt() (interface{}, error) {
 	}
 
 	// get input and return
-	return c.getBool(false)
+	answer, err := c.getBool(false)
+	if err == SkipErr {
+		// the prompt isn't cleaned up after an error so show the answer now
+		if err := c.render(ConfirmTemplateData{Confirm: *c, Answer: c.Skip}); err != nil {
+			return answer, err
+		}
+	}
+	return answer, err
 }
 
 // Cleanup overwrite the line with the finalized formatted version
@@ -131,8 +203,17 @@ func (c *Confirm) Cleanup(val interface{}) error {
 	// if the value was previously true
 	ans := yesNo(val.(bool))
 	// render the template
-	return c.Render(
-		ConfirmQuestionTemplate,
+	return c.render(
 		ConfirmTemplateData{Confirm: *c, Answer: ans},
 	)
 }
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of ConfirmQuestionTemplate.
+func (c *Confirm) render(data ConfirmTemplateData) error {
+	tmpl := ConfirmQuestionTemplate
+	if c.Template != "" {
+		tmpl = c.Template
+	}
+	return c.RenderWithFuncs(tmpl, c.TemplateFuncs, data)
+}
@@ -2,6 +2,7 @@ package survey
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,18 @@ func TestConfirmRender(t *testing.T) {
 			`ⓘ This is helpful
 ? Is pizza your favorite food? (y/N) `,
 		},
+		{
+			"Test Confirm question output without a default",
+			Confirm{Message: "Is pizza your favorite food?", Default: true, NoDefault: true},
+			ConfirmTemplateData{},
+			`? Is pizza your favorite food? (y/n) `,
+		},
+		{
+			"Test Confirm question output with a skip answer",
+			Confirm{Message: "Is pizza your favorite food?", Skip: "skip"},
+			ConfirmTemplateData{},
+			`? Is pizza your favorite food? (y/N/skip) `,
+		},
 	}
 
 	outputBuffer := bytes.NewBufferString("")
@@ -69,3 +82,40 @@ func TestConfirmRender(t *testing.T) {
 		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
 	}
 }
+
+func TestConfirm_answer(t *testing.T) {
+	tests := []struct {
+		title    string
+		prompt   Confirm
+		input    string
+		expected bool
+		err      string
+	}{
+		{"yes", Confirm{}, "Yes", true, ""},
+		{"no", Confirm{Default: true}, "n", false, ""},
+		{"empty takes the default", Confirm{Default: true}, "", true, ""},
+		{"empty without a default", Confirm{NoDefault: true}, "", false, "an answer is required, please try again."},
+		{"invalid", Confirm{}, "maybe", false, `"maybe" is not a valid answer, please try again.`},
+		{"skip label", Confirm{Skip: "Cancel"}, "cancel", false, "skipped"},
+		{"skip letter", Confirm{Skip: "cancel", Default: true}, "C", true, "skipped"},
+		{"skip letter without a skip answer", Confirm{}, "s", false, `"s" is not a valid answer, please try again.`},
+	}
+
+	for _, test := range tests {
+		answer, err := test.prompt.answer(test.input)
+		assert.Equal(t, test.expected, answer, test.title)
+		if test.err == "" {
+			assert.Nil(t, err, test.title)
+		} else {
+			assert.EqualError(t, err, test.err, test.title)
+		}
+	}
+}
+
+func TestConfirm_skipLabelStartingWithYesOrNo(t *testing.T) {
+	for _, skip := range []string{"never", "Yield"} {
+		prompt := &Confirm{Message: "Is pizza your favorite food?", Skip: skip}
+		_, err := prompt.Prompt()
+		assert.EqualError(t, err, fmt.Sprintf("the Skip label %q can't start with y or n", skip))
+	}
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/mgutz/ansi"
+)
+
+// Config holds the settings used to render prompts. Unlike the package level variables
+// it mirrors, every Config has its own template cache so prompts using different configs
+// can safely be rendered at the same time.
+type Config struct {
+	DisableColor bool
+	Accessible   bool
+	// PageSize is the default number of options shown by prompts with a list, zero uses
+	// the package default
+	PageSize      int
+	HelpInputRune rune
+
+	ErrorIcon          string
+	HelpIcon           string
+	QuestionIcon       string
+	SuccessIcon        string
+	MarkedOptionIcon   string
+	UnmarkedOptionIcon string
+	PartialOptionIcon  string
+	RatingIcon         string
+	EmptyRatingIcon    string
+	SelectFocusIcon    string
+
+	// TemplateFuncs are made available to every template rendered with this config on
+	// top of the package level TemplateFuncs. They are read the first time the config
+	// renders a template.
+	TemplateFuncs map[string]interface{}
+
+	once      sync.Once
+	global    bool
+	templates *templateCache
+	funcs     map[string]interface{}
+}
+
+// NewConfig returns a Config initialized with the current value of the package level settings.
+func NewConfig() *Config {
+	return &Config{
+		DisableColor:       DisableColor,
+		Accessible:         Accessible,
+		HelpInputRune:      HelpInputRune,
+		ErrorIcon:          ErrorIcon,
+		HelpIcon:           HelpIcon,
+		QuestionIcon:       QuestionIcon,
+		SuccessIcon:        SuccessIcon,
+		MarkedOptionIcon:   MarkedOptionIcon,
+		UnmarkedOptionIcon: UnmarkedOptionIcon,
+		PartialOptionIcon:  PartialOptionIcon,
+		RatingIcon:         RatingIcon,
+		EmptyRatingIcon:    EmptyRatingIcon,
+		SelectFocusIcon:    SelectFocusIcon,
+	}
+}
+
+// globalConfig returns a snapshot of the package level settings that renders with the
+// package level template functions and cache. It is used by renderers that were not
+// given a config of their own.
+func globalConfig() *Config {
+	config := NewConfig()
+	config.global = true
+	return config
+}
+
+func (c *Config) setup() {
+	c.once.Do(func() {
+		// the package level settings are read by the package level functions directly
+		if c.global {
+			c.templates = globalTemplates
+			c.funcs = TemplateFuncs
+			return
+		}
+
+		c.templates = newTemplateCache()
+		c.funcs = map[string]interface{}{}
+		for name, fn := range TemplateFuncs {
+			c.funcs[name] = fn
+		}
+		// replace the functions that read package level settings with ones reading the config
+		c.funcs["color"] = func(color string) string {
+			if c.DisableColor {
+				return ""
+			}
+			return ansi.ColorCode(color)
+		}
+		c.funcs["HelpInputRune"] = func() string {
+			return string(c.HelpInputRune)
+		}
+		c.funcs["ErrorIcon"] = func() string {
+			return c.ErrorIcon
+		}
+		c.funcs["HelpIcon"] = func() string {
+			return c.HelpIcon
+		}
+		c.funcs["QuestionIcon"] = func() string {
+			return c.QuestionIcon
+		}
+		c.funcs["SuccessIcon"] = func() string {
+			return c.SuccessIcon
+		}
+		c.funcs["MarkedOptionIcon"] = func() string {
+			return c.MarkedOptionIcon
+		}
+		c.funcs["UnmarkedOptionIcon"] = func() string {
+			return c.UnmarkedOptionIcon
+		}
+		c.funcs["PartialOptionIcon"] = func() string {
+			return c.PartialOptionIcon
+		}
+		c.funcs["RatingIcon"] = func() string {
+			return c.RatingIcon
+		}
+		c.funcs["EmptyRatingIcon"] = func() string {
+			return c.EmptyRatingIcon
+		}
+		c.funcs["SelectFocusIcon"] = func() string {
+			return c.SelectFocusIcon
+		}
+		for name, fn := range c.TemplateFuncs {
+			c.funcs[name] = fn
+		}
+	})
+}
+
+// RunTemplate renders the template with the settings of the config. The given functions
+// are made available to the template on top of the ones of the config.
+func (c *Config) RunTemplate(tmpl string, funcs map[string]interface{}, data interface{}) (string, error) {
+	c.setup()
+
+	t, err := c.templates.get(tmpl, c.funcs, funcs)
+	if err != nil {
+		return "", err
+	}
+	return executeTemplate(t, data)
+}
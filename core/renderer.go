@@ -1,26 +1,76 @@
 package core
 
 import (
+	"fmt"
+	"os"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
 )
 
+// Accessible switches every prompt to an append-only mode that never moves the
+// cursor or redraws previous output, which keeps screen readers from announcing
+// the redraws as noise. It can also be enabled by setting the SURVEY_ACCESSIBLE
+// environment variable.
+var Accessible = os.Getenv("SURVEY_ACCESSIBLE") != ""
+
 type Renderer struct {
+	stdio          terminal.Stdio
+	config         *Config
 	lineCount      int
 	errorLineCount int
+	cursorUp       int
 }
 
 var ErrorTemplate = `{{color "red"}}{{ ErrorIcon }} Sorry, your reply was invalid: {{.Error}}{{color "reset"}}
 `
 
+// WithStdio makes the prompt read from and write to the given files instead of the
+// standard streams.
+func (r *Renderer) WithStdio(stdio terminal.Stdio) {
+	r.stdio = stdio
+}
+
+// WithConfig makes the prompt use the given settings instead of the package level ones.
+// A nil config goes back to the package level settings.
+func (r *Renderer) WithConfig(config *Config) {
+	r.config = config
+}
+
+// Config returns the settings the prompt is rendered with.
+func (r *Renderer) Config() *Config {
+	if r.config == nil {
+		return globalConfig()
+	}
+	return r.config
+}
+
+// Stdio returns the files the prompt reads from and writes to.
+func (r *Renderer) Stdio() terminal.Stdio {
+	return r.stdio.WithDefaults()
+}
+
+// NewRuneReader returns a reader for the input of the prompt that echoes to its output.
+func (r *Renderer) NewRuneReader() *terminal.RuneReader {
+	rr := terminal.NewRuneReader(r.Stdio().In)
+	rr.Output = r.stdio.Out
+	return rr
+}
+
+// NewCursor returns a cursor for the terminal the prompt writes to.
+func (r *Renderer) NewCursor() *terminal.Cursor {
+	return &terminal.Cursor{Out: r.stdio.Out}
+}
+
 func (r *Renderer) Error(invalid error) error {
 	// since errors are printed on top we need to reset the prompt
 	// as well as any previous error print
 	r.resetPrompt(r.lineCount + r.errorLineCount)
 	// we just cleared the prompt lines
 	r.lineCount = 0
-	out, err := RunTemplate(ErrorTemplate, invalid)
+	out, err := r.Config().RunTemplate(ErrorTemplate, nil, invalid)
 	if err != nil {
 		return err
 	}
@@ -28,35 +78,97 @@ func (r *Renderer) Error(invalid error) error {
 	r.errorLineCount = strings.Count(out, "\n")
 
 	// send the message to the user
-	terminal.Print(out)
+	fmt.Fprint(r.Stdio().Out, out)
 	return nil
 }
 
 func (r *Renderer) resetPrompt(lines int) {
+	// accessible output is only ever appended so there is nothing to erase
+	if r.Config().Accessible {
+		return
+	}
+
+	// start from the bottom of the prompt if the cursor was left inside of it
+	r.RestoreCursor()
+
+	cursor := r.NewCursor()
 	// clean out current line in case tmpl didnt end in newline
-	terminal.CursorHorizontalAbsolute(0)
-	terminal.EraseLine(terminal.ERASE_LINE_ALL)
+	cursor.HorizontalAbsolute(0)
+	cursor.EraseLine(terminal.ERASE_LINE_ALL)
 	// clean up what we left behind last time
 	for i := 0; i < lines; i++ {
-		terminal.CursorPreviousLine(1)
-		terminal.EraseLine(terminal.ERASE_LINE_ALL)
+		cursor.PreviousLine(1)
+		cursor.EraseLine(terminal.ERASE_LINE_ALL)
 	}
 }
 
 func (r *Renderer) Render(tmpl string, data interface{}) error {
+	return r.RenderWithFuncs(tmpl, nil, data)
+}
+
+// RenderWithFuncs is like Render but makes the given functions available to the
+// template on top of TemplateFuncs.
+func (r *Renderer) RenderWithFuncs(tmpl string, funcs map[string]interface{}, data interface{}) error {
+	_, err := r.render(tmpl, funcs, data)
+	return err
+}
+
+// ansiSequence matches the escape sequences used for colors and cursor movements, which
+// take no room on the screen
+var ansiSequence = regexp.MustCompile("\x1b\\[[0-9;?]*[A-Za-z]")
+
+// RenderWithCursor is like RenderWithFuncs but leaves the cursor inside of the rendered
+// text instead of after it, up lines above the last line and back columns before the
+// end of that line. It is used by prompts that draw more below the line being typed.
+// The next render, or RestoreCursor, moves the cursor back down.
+func (r *Renderer) RenderWithCursor(tmpl string, funcs map[string]interface{}, data interface{}, up, back int) error {
+	out, err := r.render(tmpl, funcs, data)
+	if err != nil || r.Config().Accessible {
+		return err
+	}
+
+	lines := strings.Split(out, "\n")
+	if up >= len(lines) {
+		up = len(lines) - 1
+	}
+	col := utf8.RuneCountInString(ansiSequence.ReplaceAllString(lines[len(lines)-1-up], "")) - back
+
+	cursor := r.NewCursor()
+	if up > 0 {
+		cursor.PreviousLine(up)
+	}
+	cursor.HorizontalAbsolute(0)
+	if col > 0 {
+		cursor.Forward(col)
+	}
+	r.cursorUp = up
+
+	return nil
+}
+
+// RestoreCursor moves the cursor back under the prompt after RenderWithCursor left it
+// inside, so whatever is printed next doesn't overwrite the prompt.
+func (r *Renderer) RestoreCursor() {
+	if r.cursorUp > 0 {
+		r.NewCursor().NextLine(r.cursorUp)
+		r.cursorUp = 0
+	}
+}
+
+func (r *Renderer) render(tmpl string, funcs map[string]interface{}, data interface{}) (string, error) {
 	r.resetPrompt(r.lineCount)
 	// render the template summarizing the current state
-	out, err := RunTemplate(tmpl, data)
+	out, err := r.Config().RunTemplate(tmpl, funcs, data)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// keep track of how many lines are printed so we can clean up later
 	r.lineCount = strings.Count(out, "\n")
 
 	// print the summary
-	terminal.Print(out)
+	fmt.Fprint(r.Stdio().Out, out)
 
 	// nothing went wrong
-	return nil
+	return out, nil
 }
@@ -0,0 +1,26 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestRenderWithCursor_movesBackDownBeforeTheNextRender(t *testing.T) {
+	out := bytes.NewBufferString("")
+	r := &Renderer{}
+	r.WithStdio(terminal.Stdio{Out: out})
+	r.WithConfig(&Config{DisableColor: true})
+
+	err := r.RenderWithCursor("{{.}}\nfirst\nsecond\n", nil, "value", 3, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, r.cursorUp)
+	assert.Equal(t, 3, r.lineCount)
+
+	err = r.Render("done\n", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, r.cursorUp)
+	assert.Equal(t, 1, r.lineCount)
+}
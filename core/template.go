@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"sync"
 	"text/template"
 
 	"github.com/mgutz/ansi"
@@ -15,9 +16,14 @@ var (
 	ErrorIcon    = "✘"
 	HelpIcon     = "ⓘ"
 	QuestionIcon = "?"
+	SuccessIcon  = "✔"
 
 	MarkedOptionIcon   = "◉"
 	UnmarkedOptionIcon = "◯"
+	PartialOptionIcon  = "◐"
+
+	RatingIcon      = "★"
+	EmptyRatingIcon = "☆"
 
 	SelectFocusIcon = "❯"
 )
@@ -42,42 +48,93 @@ var TemplateFuncs = map[string]interface{}{
 	"QuestionIcon": func() string {
 		return QuestionIcon
 	},
+	"SuccessIcon": func() string {
+		return SuccessIcon
+	},
 	"MarkedOptionIcon": func() string {
 		return MarkedOptionIcon
 	},
 	"UnmarkedOptionIcon": func() string {
 		return UnmarkedOptionIcon
 	},
+	"PartialOptionIcon": func() string {
+		return PartialOptionIcon
+	},
+	"RatingIcon": func() string {
+		return RatingIcon
+	},
+	"EmptyRatingIcon": func() string {
+		return EmptyRatingIcon
+	},
 	"SelectFocusIcon": func() string {
 		return SelectFocusIcon
 	},
+	// inc is used to number the options of the accessible templates starting from 1
+	"inc": func(i int) int {
+		return i + 1
+	},
 }
 
-var memoizedGetTemplate = map[string]*template.Template{}
+// templates parsed with the default functions are cached by their text
+type templateCache struct {
+	mu        sync.Mutex
+	templates map[string]*template.Template
+}
+
+func newTemplateCache() *templateCache {
+	return &templateCache{templates: map[string]*template.Template{}}
+}
+
+// the cache used by the package level settings
+var globalTemplates = newTemplateCache()
+
+func (tc *templateCache) get(tmpl string, base map[string]interface{}, funcs map[string]interface{}) (*template.Template, error) {
+	// the extra functions of a prompt could change or go away with it so the templates
+	// using them are parsed every time instead of being cached
+	if len(funcs) > 0 {
+		// the extra functions are added after the base ones so they can override them
+		return template.New("prompt").Funcs(base).Funcs(funcs).Parse(tmpl)
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
 
-func getTemplate(tmpl string) (*template.Template, error) {
-	if t, ok := memoizedGetTemplate[tmpl]; ok {
+	if t, ok := tc.templates[tmpl]; ok {
 		return t, nil
 	}
 
-	t, err := template.New("prompt").Funcs(TemplateFuncs).Parse(tmpl)
+	t, err := template.New("prompt").Funcs(base).Parse(tmpl)
 	if err != nil {
 		return nil, err
 	}
 
-	memoizedGetTemplate[tmpl] = t
+	tc.templates[tmpl] = t
 	return t, nil
 }
 
-func RunTemplate(tmpl string, data interface{}) (string, error) {
-	t, err := getTemplate(tmpl)
+func getTemplate(tmpl string, funcs map[string]interface{}) (*template.Template, error) {
+	return globalTemplates.get(tmpl, TemplateFuncs, funcs)
+}
+
+func executeTemplate(t *template.Template, data interface{}) (string, error) {
+	buf := bytes.NewBufferString("")
+	err := t.Execute(buf, data)
 	if err != nil {
 		return "", err
 	}
-	buf := bytes.NewBufferString("")
-	err = t.Execute(buf, data)
+	return buf.String(), err
+}
+
+func RunTemplate(tmpl string, data interface{}) (string, error) {
+	return RunTemplateWithFuncs(tmpl, nil, data)
+}
+
+// RunTemplateWithFuncs is like RunTemplate but makes the given functions available to
+// the template on top of TemplateFuncs. Templates with extra functions are not cached.
+func RunTemplateWithFuncs(tmpl string, funcs map[string]interface{}, data interface{}) (string, error) {
+	t, err := getTemplate(tmpl, funcs)
 	if err != nil {
 		return "", err
 	}
-	return buf.String(), err
+	return executeTemplate(t, data)
 }
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunTemplateWithFuncs_addsFunctions(t *testing.T) {
+	funcs := map[string]interface{}{
+		"shout": func(s string) string { return s + "!" },
+	}
+
+	out, err := RunTemplateWithFuncs(`{{ shout . }}`, funcs, "hello")
+	assert.Nil(t, err)
+	assert.Equal(t, "hello!", out)
+}
+
+func TestRunTemplateWithFuncs_overridesDefaults(t *testing.T) {
+	funcs := map[string]interface{}{
+		"QuestionIcon": func() string { return ">" },
+	}
+
+	out, err := RunTemplateWithFuncs(`{{ QuestionIcon }}`, funcs, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ">", out)
+
+	// the same template without the extra functions should not be affected
+	out, err = RunTemplate(`{{ QuestionIcon }}`, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, QuestionIcon, out)
+}
+
+func TestRunTemplateWithFuncs_notCached(t *testing.T) {
+	for _, suffix := range []string{"!", "?"} {
+		suffix := suffix
+		funcs := map[string]interface{}{
+			"shout": func(s string) string { return s + suffix },
+		}
+		out, err := RunTemplateWithFuncs(`{{ shout . }}`, funcs, "hello")
+		assert.Nil(t, err)
+		assert.Equal(t, "hello"+suffix, out)
+	}
+
+	// only the templates using the default functions are kept
+	_, err := RunTemplate(`{{ QuestionIcon }} cached`, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, globalTemplates.templates, `{{ QuestionIcon }} cached`)
+	assert.NotContains(t, globalTemplates.templates, `{{ shout . }}`)
+}
+
+func TestConfig_RunTemplate_usesItsOwnSettings(t *testing.T) {
+	config := NewConfig()
+	config.DisableColor = true
+	config.QuestionIcon = ">"
+
+	out, err := config.RunTemplate(`{{ color "red" }}{{ QuestionIcon }}`, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ">", out)
+
+	// the package level settings should not be affected
+	assert.Equal(t, "?", QuestionIcon)
+}
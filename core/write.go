@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -16,7 +17,28 @@ type settable interface {
 	WriteAnswer(field string, value interface{}) error
 }
 
+// Answers holds the answers to several questions at once, keyed by their names, like the
+// fields of a form. Writing it writes every answer on its own to the field or key with
+// its name, so the name it is written under is ignored.
+type Answers map[string]interface{}
+
 func WriteAnswer(t interface{}, name string, v interface{}) (err error) {
+	// write the answers one by one, in a predictable order
+	if answers, ok := v.(Answers); ok {
+		names := make([]string, 0, len(answers))
+		for name := range answers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if err := WriteAnswer(t, name, answers[name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	// if the field is a custom type
 	if s, ok := t.(settable); ok {
 		// use the interface method
@@ -35,6 +57,13 @@ func WriteAnswer(t interface{}, name string, v interface{}) (err error) {
 	// the object "inside" of the target pointer
 	elem := target.Elem()
 
+	// if the answer is already of the right type (a time.Time for example) there
+	// is nothing to look up or convert
+	if value.IsValid() && elem.Kind() != reflect.Map && value.Type() == elem.Type() {
+		elem.Set(value)
+		return nil
+	}
+
 	// handle the special types
 	switch elem.Kind() {
 	// if we are writing to a struct
@@ -119,6 +148,49 @@ func isList(v reflect.Value) bool {
 	}
 }
 
+// isNumber returns true if the element is an integer or a float
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNegative returns true if the number is below zero
+func isNegative(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() < 0
+	default:
+		return false
+	}
+}
+
+// convertNumber converts a number to the numeric type of the target as long as its value is
+// kept, which rules out a fraction written to an integer or a negative number written to an
+// unsigned one. Floats only have to be in range and may lose some precision.
+func convertNumber(v reflect.Value, t reflect.Value) (reflect.Value, error) {
+	converted := v.Convert(t.Type())
+
+	var kept bool
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		kept = v.Kind() != reflect.Float64 || !t.OverflowFloat(v.Float())
+	default:
+		kept = converted.Convert(v.Type()).Interface() == v.Interface() && isNegative(converted) == isNegative(v)
+	}
+	if !kept {
+		return converted, fmt.Errorf("cannot write %v to a value of type %s without changing it", v.Interface(), t.Type())
+	}
+	return converted, nil
+}
+
 // Write takes a value and copies it to the target
 func copy(t reflect.Value, v reflect.Value) (err error) {
 	// if something ends up panicing we need to catch it in a deferred func
@@ -212,6 +284,22 @@ func copy(t reflect.Value, v reflect.Value) (err error) {
 		return
 	}
 
+	// if we are copying a number to a different numeric type
+	if isNumber(v) && isNumber(t) && v.Type() != t.Type() {
+		converted, converr := convertNumber(v, t)
+		if converr != nil {
+			return converr
+		}
+		t.Set(converted)
+		return
+	}
+
+	// if we are copying a number to a string
+	if isNumber(v) && t.Kind() == reflect.String {
+		t.SetString(fmt.Sprint(v.Interface()))
+		return
+	}
+
 	// if we are copying from one slice or array to another
 	if isList(v) && isList(t) {
 		// loop over every item in the desired value
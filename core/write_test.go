@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -541,3 +542,86 @@ func check(t *testing.T, err error) {
 		t.Fatalf("Encountered error while writing answer: %v", err.Error())
 	}
 }
+
+func TestWrite_canConvertBetweenNumbers(t *testing.T) {
+	// a pointer to hold a different numeric type than the answer
+	ptr := int64(0)
+
+	err := WriteAnswer(&ptr, "", 42)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), ptr)
+
+	floatPtr := float32(0)
+	err = WriteAnswer(&floatPtr, "", 1.5)
+	assert.Nil(t, err)
+	assert.Equal(t, float32(1.5), floatPtr)
+}
+
+func TestWrite_refusesLossyNumberConversions(t *testing.T) {
+	intPtr := 0
+	err := WriteAnswer(&intPtr, "", 2.7)
+	assert.EqualError(t, err, "cannot write 2.7 to a value of type int without changing it")
+	assert.Equal(t, 0, intPtr)
+
+	uintPtr := uint(0)
+	err = WriteAnswer(&uintPtr, "", -1)
+	assert.EqualError(t, err, "cannot write -1 to a value of type uint without changing it")
+	assert.Equal(t, uint(0), uintPtr)
+
+	int8Ptr := int8(0)
+	err = WriteAnswer(&int8Ptr, "", 300)
+	assert.EqualError(t, err, "cannot write 300 to a value of type int8 without changing it")
+
+	// a whole float fits in an integer
+	err = WriteAnswer(&intPtr, "", 3.0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, intPtr)
+}
+
+func TestWrite_canWriteNumberToString(t *testing.T) {
+	ptr := ""
+
+	err := WriteAnswer(&ptr, "", 1.5)
+	assert.Nil(t, err)
+	assert.Equal(t, "1.5", ptr)
+}
+
+func TestWrite_canWriteTime(t *testing.T) {
+	now := time.Date(2018, time.March, 14, 15, 9, 0, 0, time.UTC)
+
+	// a time as the whole target
+	ptr := time.Time{}
+	err := WriteAnswer(&ptr, "", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, ptr)
+
+	// a time in a struct
+	answers := struct {
+		When time.Time
+	}{}
+	err = WriteAnswer(&answers, "when", now)
+	assert.Nil(t, err)
+	assert.Equal(t, now, answers.When)
+}
+
+func TestWrite_canWriteAnswers(t *testing.T) {
+	answers := Answers{"host": "localhost", "port": "8080", "secure": true}
+
+	// every answer goes to its own field
+	login := struct {
+		Host   string
+		Port   int
+		Secure bool `survey:"secure"`
+	}{}
+	err := WriteAnswer(&login, "", answers)
+	assert.Nil(t, err)
+	assert.Equal(t, "localhost", login.Host)
+	assert.Equal(t, 8080, login.Port)
+	assert.True(t, login.Secure)
+
+	// or to its own key
+	values := map[string]interface{}{}
+	err = WriteAnswer(&values, "login", answers)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"host": "localhost", "port": "8080", "secure": true}, values)
+}
@@ -0,0 +1,444 @@
+package survey
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Date is a prompt that shows a month calendar to pick a date from. The arrow keys move
+the selection by a day or a week and page up/down by a month. The date can also be typed
+using Layout. If WithTime is set the time of day can be typed too, or changed by TimeStep
+with the arrow keys after pressing tab. Min and Max bound the dates that can be picked
+when they are set. Response type is a time.Time.
+
+	day := time.Time{}
+	prompt := &survey.Date{
+		Message: "When should the job run?",
+		Min:     time.Now(),
+	}
+	survey.AskOne(prompt, &day, nil)
+*/
+type Date struct {
+	core.Renderer
+	Message       string
+	Default       time.Time
+	Min           time.Time
+	Max           time.Time
+	Layout        string
+	WithTime      bool
+	TimeStep      time.Duration
+	WeekStart     time.Weekday
+	Help          string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	selected      time.Time
+	typed         string
+	editingTime   bool
+	showingHelp   bool
+}
+
+// DateCell is a single day of the calendar shown by a Date prompt. Cells outside of the
+// month have a Day of 0.
+type DateCell struct {
+	Day      int
+	Selected bool
+	Disabled bool
+}
+
+// data available to the templates when processing
+type DateTemplateData struct {
+	Date
+	Value       string
+	Month       string
+	WeekDays    []string
+	Weeks       [][]DateCell
+	EditingTime bool
+	// Format is the layout the date is typed with, like YYYY-MM-DD
+	Format     string
+	Answer     string
+	ShowAnswer bool
+	ShowHelp   bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var DateQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- .Value}}
+  {{- color "cyan"}} [{{ if .EditingTime }}Use arrows to change the time, tab for the date{{ else }}Use arrows and page up/down to move
+  {{- if .WithTime }}, tab for the time{{ end }}{{ end }}, type to enter a date
+  {{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}{{"\n"}}
+  {{- color "default+hb"}}{{ .Month }}{{color "reset"}}{{"\n"}}
+  {{- range .WeekDays}} {{.}} {{end}}{{"\n"}}
+  {{- range .Weeks}}
+    {{- range .}}
+      {{- if not .Day}}{{"    "}}
+      {{- else if .Selected}}{{color "cyan+b"}}[{{printf "%2d" .Day}}]{{color "reset"}}
+      {{- else if .Disabled}}{{color "black+h"}} {{printf "%2d" .Day}} {{color "reset"}}
+      {{- else}} {{printf "%2d" .Day}} {{end}}
+    {{- end}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// DateAccessibleQuestionTemplate is used instead of DateQuestionTemplate when
+// core.Accessible is set. There is no calendar, the user types the date in the format it
+// is shown with.
+var DateAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- color "cyan"}}Type a date as {{.Format}} (default: {{.Value}})
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
+// layoutFormat shows the numbers of a layout the way people write date formats
+var layoutFormat = strings.NewReplacer("2006", "YYYY", "01", "MM", "02", "DD", "15", "hh", "04", "mm", "05", "ss")
+
+// layout returns the layout dates are shown and typed with
+func (d *Date) layout() string {
+	if d.Layout != "" {
+		return d.Layout
+	}
+	if d.WithTime {
+		return "2006-01-02 15:04"
+	}
+	return "2006-01-02"
+}
+
+// bounds returns the range of the prompt. Without a time of day the whole days of Min
+// and Max are allowed, so Min can be time.Now() and still allow today.
+func (d *Date) bounds() (min, max time.Time) {
+	min, max = d.Min, d.Max
+	if !d.WithTime {
+		if !min.IsZero() {
+			min = time.Date(min.Year(), min.Month(), min.Day(), 0, 0, 0, 0, min.Location())
+		}
+		if !max.IsZero() {
+			max = time.Date(max.Year(), max.Month(), max.Day()+1, 0, 0, 0, 0, max.Location()).Add(-time.Nanosecond)
+		}
+	}
+	return min, max
+}
+
+// inRange returns true if the date can be picked
+func (d *Date) inRange(t time.Time) bool {
+	min, max := d.bounds()
+	if !min.IsZero() && t.Before(min) {
+		return false
+	}
+	if !max.IsZero() && t.After(max) {
+		return false
+	}
+	return true
+}
+
+// clamp moves the date inside of the range
+func (d *Date) clamp(t time.Time) time.Time {
+	min, max := d.bounds()
+	if !min.IsZero() && t.Before(min) {
+		return min
+	}
+	if !max.IsZero() && t.After(max) {
+		if !d.WithTime {
+			// stay on the last day rather than the last nanosecond of it
+			return time.Date(max.Year(), max.Month(), max.Day(), 0, 0, 0, 0, max.Location())
+		}
+		return max
+	}
+	return t
+}
+
+// addMonths moves the date by whole months, staying on the last day of the month when
+// the target month is shorter instead of overflowing into the next one
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	day := t.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// OnChange is called on every keypress.
+func (d *Date) OnChange(key rune) {
+	step := d.TimeStep
+	if step == 0 {
+		step = 15 * time.Minute
+	}
+
+	switch {
+	case key == '\t' && d.WithTime:
+		d.editingTime = !d.editingTime
+	case key == terminal.KeyArrowLeft && d.editingTime:
+		d.move(d.selected.Add(-time.Hour))
+	case key == terminal.KeyArrowRight && d.editingTime:
+		d.move(d.selected.Add(time.Hour))
+	case key == terminal.KeyArrowUp && d.editingTime:
+		d.move(d.selected.Add(step))
+	case key == terminal.KeyArrowDown && d.editingTime:
+		d.move(d.selected.Add(-step))
+	case key == terminal.KeyArrowLeft:
+		d.move(d.selected.AddDate(0, 0, -1))
+	case key == terminal.KeyArrowRight:
+		d.move(d.selected.AddDate(0, 0, 1))
+	case key == terminal.KeyArrowUp:
+		d.move(d.selected.AddDate(0, 0, -7))
+	case key == terminal.KeyArrowDown:
+		d.move(d.selected.AddDate(0, 0, 7))
+	case key == terminal.KeyPageUp:
+		d.move(addMonths(d.selected, -1))
+	case key == terminal.KeyPageDown:
+		d.move(addMonths(d.selected, 1))
+	case key == d.Config().HelpInputRune && d.Help != "":
+		d.showingHelp = true
+	case key == terminal.KeyEscape || key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		d.typed = ""
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if d.typed != "" {
+			runes := []rune(d.typed)
+			d.typed = string(runes[:len(runes)-1])
+		}
+	case terminal.IsPrintable(key):
+		d.typed += string(key)
+		// follow along in the calendar as soon as the date makes sense
+		if t, err := d.parse(d.typed); err == nil {
+			d.selected = t
+		}
+	}
+}
+
+// move selects the date if it is in the range, clearing what was typed
+func (d *Date) move(t time.Time) {
+	d.typed = ""
+	d.selected = d.clamp(t)
+}
+
+// parse reads a date typed in the layout of the prompt
+func (d *Date) parse(value string) (time.Time, error) {
+	loc := time.Local
+	if !d.Default.IsZero() {
+		loc = d.Default.Location()
+	}
+
+	t, err := time.ParseInLocation(d.layout(), strings.TrimSpace(value), loc)
+	if err != nil {
+		return t, fmt.Errorf("%q is not a valid date, please use the format %v.", value, d.layout())
+	}
+	return t, nil
+}
+
+// answer returns the date the user picked or typed
+func (d *Date) answer() (time.Time, error) {
+	selected := d.selected
+	if d.typed != "" {
+		t, err := d.parse(d.typed)
+		if err != nil {
+			return t, err
+		}
+		selected = t
+	}
+
+	if !d.inRange(selected) {
+		return selected, fmt.Errorf("%v is not between %v and %v, please try again.",
+			selected.Format(d.layout()), d.describe(d.Min), d.describe(d.Max))
+	}
+	return selected, nil
+}
+
+// describe formats one end of the range for an error message
+func (d *Date) describe(t time.Time) string {
+	if t.IsZero() {
+		return "any date"
+	}
+	return t.Format(d.layout())
+}
+
+// calendar builds the grid of the month of the selected date, starting the weeks on WeekStart
+func (d *Date) calendar() (weekDays []string, weeks [][]DateCell) {
+	for i := 0; i < 7; i++ {
+		weekDays = append(weekDays, time.Weekday((int(d.WeekStart) + i) % 7).String()[:2])
+	}
+
+	first := time.Date(d.selected.Year(), d.selected.Month(), 1, 0, 0, 0, 0, d.selected.Location())
+	last := first.AddDate(0, 1, -1).Day()
+
+	// pad the first week up to the first day of the month
+	week := []DateCell{}
+	for i := 0; i < (int(first.Weekday())-int(d.WeekStart)+7)%7; i++ {
+		week = append(week, DateCell{})
+	}
+
+	for day := 1; day <= last; day++ {
+		date := first.AddDate(0, 0, day-1)
+		// a day is available if any part of it is in the range
+		min, max := d.bounds()
+		available := (min.IsZero() || date.AddDate(0, 0, 1).After(min)) && (max.IsZero() || !date.After(max))
+
+		week = append(week, DateCell{
+			Day:      day,
+			Selected: day == d.selected.Day(),
+			Disabled: !available,
+		})
+		if len(week) == 7 {
+			weeks = append(weeks, week)
+			week = []DateCell{}
+		}
+	}
+
+	if len(week) > 0 {
+		weeks = append(weeks, week)
+	}
+
+	return weekDays, weeks
+}
+
+func (d *Date) Prompt() (interface{}, error) {
+	// start from the default date, or today
+	d.selected = d.Default
+	if d.selected.IsZero() {
+		d.selected = time.Now()
+		if !d.WithTime {
+			d.selected = time.Date(d.selected.Year(), d.selected.Month(), d.selected.Day(), 0, 0, 0, 0, time.Local)
+		}
+	}
+	d.selected = d.clamp(d.selected)
+	d.typed = ""
+	d.editingTime = false
+	d.showingHelp = false
+
+	rr := d.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts don't redraw on every key so the date is typed as a line
+	if d.Config().Accessible {
+		return d.accessiblePrompt(rr)
+	}
+
+	// the cursor would only get in the way of the calendar
+	cursor := d.NewCursor()
+	cursor.Hide()
+	defer cursor.Show()
+
+	err := d.render(d.questionData())
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			val, err := d.answer()
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := d.Error(err); err != nil {
+				return nil, err
+			}
+		} else {
+			d.OnChange(r)
+		}
+
+		err = d.render(d.questionData())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (d *Date) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	err := d.renderAccessible(false)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return nil, err
+		}
+
+		showHelp := false
+		if string(line) == string(d.Config().HelpInputRune) && d.Help != "" {
+			showHelp = true
+		} else {
+			// an empty line keeps the initial date
+			d.typed = strings.TrimSpace(string(line))
+			val, err := d.answer()
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := d.Error(err); err != nil {
+				return nil, err
+			}
+		}
+
+		err = d.renderAccessible(showHelp)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (d *Date) Cleanup(val interface{}) error {
+	return d.render(DateTemplateData{
+		Date:       *d,
+		Answer:     val.(time.Time).Format(d.layout()),
+		ShowAnswer: true,
+	})
+}
+
+// questionData returns the data to render the calendar of the selected date
+func (d *Date) questionData() DateTemplateData {
+	value := d.typed
+	if value == "" {
+		value = d.selected.Format(d.layout())
+	}
+
+	weekDays, weeks := d.calendar()
+	return DateTemplateData{
+		Date:        *d,
+		Value:       value,
+		Month:       d.selected.Format("January 2006"),
+		WeekDays:    weekDays,
+		Weeks:       weeks,
+		EditingTime: d.editingTime,
+		ShowHelp:    d.showingHelp,
+	}
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of DateQuestionTemplate.
+func (d *Date) render(data DateTemplateData) error {
+	tmpl := DateQuestionTemplate
+	if d.Template != "" {
+		tmpl = d.Template
+	}
+	return d.RenderWithFuncs(tmpl, d.TemplateFuncs, data)
+}
+
+// renderAccessible asks for the date to type with DateAccessibleQuestionTemplate
+func (d *Date) renderAccessible(showHelp bool) error {
+	return d.RenderWithFuncs(DateAccessibleQuestionTemplate, d.TemplateFuncs, DateTemplateData{
+		Date:     *d,
+		Value:    d.selected.Format(d.layout()),
+		Format:   layoutFormat.Replace(d.layout()),
+		ShowHelp: showHelp,
+	})
+}
@@ -0,0 +1,199 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+func TestDateRender(t *testing.T) {
+	march := time.Date(2018, time.March, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		title    string
+		prompt   Date
+		expected string
+	}{
+		{
+			"Test Date question output",
+			Date{Message: "When?", Max: time.Date(2018, time.March, 28, 0, 0, 0, 0, time.UTC)},
+			"? When? 2018-03-14 [Use arrows and page up/down to move, type to enter a date]\n" +
+				"March 2018\n" +
+				" Su  Mo  Tu  We  Th  Fr  Sa \n" +
+				"                  1   2   3 \n" +
+				"  4   5   6   7   8   9  10 \n" +
+				" 11  12  13 [14] 15  16  17 \n" +
+				" 18  19  20  21  22  23  24 \n" +
+				" 25  26  27  28  29  30  31 \n",
+		},
+		{
+			"Test Date question output starting on monday with time",
+			Date{Message: "When?", WeekStart: time.Monday, WithTime: true},
+			"? When? 2018-03-14 00:00 [Use arrows and page up/down to move, tab for the time, type to enter a date]\n" +
+				"March 2018\n" +
+				" Mo  Tu  We  Th  Fr  Sa  Su \n" +
+				"              1   2   3   4 \n" +
+				"  5   6   7   8   9  10  11 \n" +
+				" 12  13 [14] 15  16  17  18 \n" +
+				" 19  20  21  22  23  24  25 \n" +
+				" 26  27  28  29  30  31 \n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.prompt.selected = march
+		err := test.prompt.Render(
+			DateQuestionTemplate,
+			test.prompt.questionData(),
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestDateRender_answer(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Date{Message: "When?"}
+	err := prompt.Cleanup(time.Date(2018, time.March, 14, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "? When? 2018-03-14\n", outputBuffer.String())
+}
+
+func TestDateAccessibleRender(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Date{
+		Message:  "When should the job run?",
+		WithTime: true,
+		Help:     "Times are local",
+		selected: time.Date(2018, time.March, 14, 15, 9, 0, 0, time.UTC),
+	}
+	err := prompt.renderAccessible(false)
+	assert.Nil(t, err)
+	// the date is typed instead of picked from a calendar
+	assert.Equal(t, "? When should the job run?\nType a date as YYYY-MM-DD hh:mm (default: 2018-03-14 15:09), ? for more help: ", outputBuffer.String())
+}
+
+func TestDate_calendarMarksDaysOutOfRange(t *testing.T) {
+	prompt := &Date{
+		Min:      time.Date(2018, time.March, 2, 12, 0, 0, 0, time.UTC),
+		Max:      time.Date(2018, time.March, 30, 0, 0, 0, 0, time.UTC),
+		selected: time.Date(2018, time.March, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	disabled := []int{}
+	for _, week := range prompt.questionData().Weeks {
+		for _, cell := range week {
+			if cell.Disabled {
+				disabled = append(disabled, cell.Day)
+			}
+		}
+	}
+	// the whole day of Min is available even though Min is at noon
+	assert.Equal(t, []int{1, 31}, disabled)
+}
+
+func TestDate_navigation(t *testing.T) {
+	prompt := &Date{
+		Max:      time.Date(2018, time.April, 20, 0, 0, 0, 0, time.UTC),
+		selected: time.Date(2018, time.January, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	steps := []struct {
+		key      rune
+		expected time.Time
+	}{
+		// a shorter month keeps the last day instead of overflowing
+		{terminal.KeyPageDown, time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyArrowRight, time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyArrowDown, time.Date(2018, time.March, 8, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyArrowLeft, time.Date(2018, time.March, 7, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyArrowUp, time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyPageUp, time.Date(2018, time.January, 28, 0, 0, 0, 0, time.UTC)},
+		// stays in the range
+		{terminal.KeyPageDown, time.Date(2018, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyPageDown, time.Date(2018, time.March, 28, 0, 0, 0, 0, time.UTC)},
+		{terminal.KeyPageDown, time.Date(2018, time.April, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, step := range steps {
+		prompt.OnChange(step.key)
+		assert.Equal(t, step.expected, prompt.selected)
+	}
+	// the page keys aren't typed into the date
+	assert.Empty(t, prompt.typed)
+
+	// ctrl+u and ctrl+v aren't taken for page up and down
+	prompt.OnChange('\x15')
+	prompt.OnChange('\x16')
+	assert.Equal(t, time.Date(2018, time.April, 20, 0, 0, 0, 0, time.UTC), prompt.selected)
+}
+
+func TestDate_timeNavigation(t *testing.T) {
+	prompt := &Date{
+		WithTime: true,
+		selected: time.Date(2018, time.March, 14, 9, 0, 0, 0, time.UTC),
+	}
+
+	// the arrows move the date until tab switches to the time
+	prompt.OnChange('\t')
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, time.Date(2018, time.March, 14, 9, 15, 0, 0, time.UTC), prompt.selected)
+	prompt.OnChange(terminal.KeyArrowRight)
+	assert.Equal(t, time.Date(2018, time.March, 14, 10, 15, 0, 0, time.UTC), prompt.selected)
+
+	prompt.OnChange('\t')
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, time.Date(2018, time.March, 7, 10, 15, 0, 0, time.UTC), prompt.selected)
+}
+
+func TestDate_typing(t *testing.T) {
+	loc := time.FixedZone("test", 0)
+	prompt := &Date{
+		Default:  time.Date(2018, time.March, 14, 0, 0, 0, 0, loc),
+		Min:      time.Date(2018, time.January, 1, 0, 0, 0, 0, loc),
+		selected: time.Date(2018, time.March, 14, 0, 0, 0, 0, loc),
+	}
+
+	// the calendar follows the date once it is complete
+	for _, key := range "2018-05-0" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, time.Date(2018, time.March, 14, 0, 0, 0, 0, loc), prompt.selected)
+	prompt.OnChange('2')
+	assert.Equal(t, time.Date(2018, time.May, 2, 0, 0, 0, 0, loc), prompt.selected)
+
+	val, err := prompt.answer()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2018, time.May, 2, 0, 0, 0, 0, loc), val)
+
+	// invalid dates are refused
+	prompt.OnChange(terminal.KeyBackspace)
+	prompt.OnChange('x')
+	_, err = prompt.answer()
+	assert.NotNil(t, err)
+
+	// and so are dates out of the range
+	prompt.OnChange(terminal.KeyEscape)
+	for _, key := range "2017-12-31" {
+		prompt.OnChange(key)
+	}
+	_, err = prompt.answer()
+	assert.NotNil(t, err)
+}
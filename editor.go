@@ -2,10 +2,14 @@ package survey
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"runtime"
+	"strings"
+	"unicode"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
@@ -23,6 +27,15 @@ Response type is a string.
 	message := ""
 	prompt := &survey.Editor{ Message: "What is your commit message?" }
 	survey.AskOne(prompt, &message, nil)
+
+The editor command is split into words like a shell would, so it can hold arguments like
+"code --wait". A File can be edited in place instead of a temporary file.
+
+Like git does for commit messages, a Comment can explain what to write, which is added to
+the temporary file as comment lines that are stripped from the answer. When the answer
+is refused by the Validate of the question, the editor opens again on the text that was
+refused with the error added as a comment. A File is opened again as it was left, the
+error only being shown above the prompt.
 */
 type Editor struct {
 	core.Renderer
@@ -31,6 +44,24 @@ type Editor struct {
 	Help          string
 	HideDefault   bool
 	AppendDefault bool
+	// Command is the editor to launch in place of $VISUAL or $EDITOR, like "code --wait"
+	Command string
+	// FilePattern names the temporary file, the last * being replaced by a random string,
+	// so that "*.md" gets the editor to highlight markdown
+	FilePattern string
+	// File is an existing file to edit in place of a temporary one, whose new contents are
+	// the answer. Default and Comment are not used.
+	File string
+	// Comment is written under the text to edit as lines starting with CommentPrefix, "#"
+	// by default, and every line starting with it is stripped from the answer
+	Comment       string
+	CommentPrefix string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	// previous is the last text written in the editor and refused the error it was
+	// refused with, for the editor to open on it again
+	previous string
+	refused  error
 }
 
 // data available to the templates when processing
@@ -71,51 +102,76 @@ func init() {
 }
 
 func (e *Editor) Prompt() (interface{}, error) {
+	// a refused answer goes straight back to the editor along with the error
+	if e.refused == nil {
+		if err := e.waitForEnter(); err != nil {
+			return "", err
+		}
+	}
+
+	// an existing file is edited as it is, without the error of a refused answer
+	if e.File != "" {
+		e.refused = nil
+		return e.edit(e.File)
+	}
+	return e.editTempFile()
+}
+
+// waitForEnter shows the question and waits for enter to be pressed to open the editor
+func (e *Editor) waitForEnter() error {
 	// render the template
-	err := e.Render(
-		EditorQuestionTemplate,
+	err := e.render(
 		EditorTemplateData{Editor: *e},
 	)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	// start reading runes from the standard in
-	rr := terminal.NewRuneReader(os.Stdin)
+	rr := e.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 
-	terminal.CursorHide()
-	defer terminal.CursorShow()
+	// a hidden cursor confuses screen readers
+	if !e.Config().Accessible {
+		e.NewCursor().Hide()
+		defer e.NewCursor().Show()
+	}
 
 	for {
 		r, _, err := rr.ReadRune()
 		if err != nil {
-			return "", err
+			return err
 		}
 		if r == '\r' || r == '\n' {
-			break
+			return nil
 		}
 		if r == terminal.KeyInterrupt {
-			return "", terminal.InterruptErr
+			return terminal.InterruptErr
 		}
 		if r == terminal.KeyEndTransmission {
-			break
+			return nil
 		}
-		if r == core.HelpInputRune && e.Help != "" {
-			err = e.Render(
-				EditorQuestionTemplate,
+		if r == e.Config().HelpInputRune && e.Help != "" {
+			err = e.render(
 				EditorTemplateData{Editor: *e, ShowHelp: true},
 			)
 			if err != nil {
-				return "", err
+				return err
 			}
 		}
-		continue
 	}
+}
 
+// editTempFile opens a temporary file holding the default value if it is appended in the
+// editor and returns its contents, or the default value if it is left empty
+func (e *Editor) editTempFile() (string, error) {
 	// prepare the temp file
-	f, err := ioutil.TempFile("", "survey")
+	pattern := e.FilePattern
+	if pattern == "" {
+		pattern = "survey"
+	}
+	f, err := ioutil.TempFile("", pattern)
 	if err != nil {
 		return "", err
 	}
@@ -131,11 +187,24 @@ func (e *Editor) Prompt() (interface{}, error) {
 		return "", err
 	}
 
-	// write default value
-	if e.Default != "" && e.AppendDefault {
-		if _, err := f.WriteString(e.Default); err != nil {
-			return "", err
+	// write default value, or the text that was refused
+	text := ""
+	if e.refused != nil {
+		text = e.previous
+	} else if e.Default != "" && e.AppendDefault {
+		text = e.Default
+	}
+	comments := e.comments()
+	e.refused = nil
+	if len(comments) > 0 {
+		// the comments go under a blank line so the text is written at the top
+		if text != "" && !strings.HasSuffix(text, "\n") {
+			text += "\n"
 		}
+		text += "\n" + strings.Join(comments, "\n") + "\n"
+	}
+	if _, err := f.WriteString(text); err != nil {
+		return "", err
 	}
 
 	// close the fd to prevent the editor unable to save file
@@ -143,36 +212,189 @@ func (e *Editor) Prompt() (interface{}, error) {
 		return "", err
 	}
 
+	text, err = e.edit(f.Name())
+	if err != nil {
+		return "", err
+	}
+	if len(comments) > 0 {
+		text = e.stripComments(text, comments)
+	}
+	e.previous = text
+
+	// check length, return default value on empty
+	if len(text) == 0 && !e.AppendDefault {
+		return e.Default, nil
+	}
+
+	return text, nil
+}
+
+// comments returns the comment lines to write under the text, the error the previous text
+// was refused with first
+func (e *Editor) comments() []string {
+	text := []string{}
+	if e.refused != nil {
+		text = append(text, e.refused.Error())
+	}
+	if e.Comment != "" {
+		text = append(text, e.Comment)
+	}
+	if len(text) == 0 {
+		return nil
+	}
+
+	comments := []string{}
+	for _, line := range strings.Split(strings.Join(text, "\n"), "\n") {
+		if line == "" {
+			comments = append(comments, e.commentPrefix())
+		} else {
+			comments = append(comments, e.commentPrefix()+" "+line)
+		}
+	}
+	return comments
+}
+
+// stripComments removes the comment lines from the text, along with the blank lines left
+// around it. Without a Comment only the comments that were written are removed, so that
+// the lines of the answer starting with the prefix are kept.
+func (e *Editor) stripComments(text string, comments []string) string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimRight(line, "\r")
+		if e.Comment != "" && strings.HasPrefix(trimmed, e.commentPrefix()) || e.Comment == "" && contains(comments, trimmed) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\r\n")
+}
+
+// commentPrefix returns what comment lines start with
+func (e *Editor) commentPrefix() string {
+	if e.CommentPrefix == "" {
+		return "#"
+	}
+	return e.CommentPrefix
+}
+
+// Error shows the error like every prompt does and remembers it, so that the editor opens
+// again on the text that was refused with the error as a comment.
+func (e *Editor) Error(invalid error) error {
+	e.refused = invalid
+	return e.Renderer.Error(invalid)
+}
+
+// edit opens the file in the editor and returns its contents once the editor exits
+func (e *Editor) edit(file string) (string, error) {
+	command := editor
+	if e.Command != "" {
+		command = e.Command
+	}
+	args, err := splitCommand(command)
+	if err != nil {
+		return "", err
+	}
+
 	// open the editor
-	cmd := exec.Command(editor, f.Name())
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	terminal.CursorShow()
+	cmd := exec.Command(args[0], append(args[1:], file)...)
+	stdio := e.Stdio()
+	cmd.Stdin = stdio.In
+	cmd.Stdout = stdio.Out
+	cmd.Stderr = stdio.Err
+	e.NewCursor().Show()
 	if err := cmd.Run(); err != nil {
 		return "", err
 	}
 
 	// raw is a BOM-unstripped UTF8 byte slice
-	raw, err := ioutil.ReadFile(f.Name())
+	raw, err := ioutil.ReadFile(file)
 	if err != nil {
 		return "", err
 	}
 
 	// strip BOM header
-	text := string(bytes.TrimPrefix(raw, bom))
+	return string(bytes.TrimPrefix(raw, bom)), nil
+}
 
-	// check length, return default value on empty
-	if len(text) == 0 && !e.AppendDefault {
-		return e.Default, nil
+// splitCommand splits the editor command into words like a shell would. Words are
+// separated by spaces unless they are quoted and single quotes keep everything as it is.
+// A backslash only escapes the characters that would mean something else, so that the
+// backslashes of Windows paths are kept.
+func splitCommand(command string) ([]string, error) {
+	args := []string{}
+	word := []rune{}
+	inWord := false
+	var quote rune
+
+	runes := []rune(command)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == quote {
+				quote = 0
+			} else {
+				word = append(word, r)
+			}
+		case r == '\\' && i+1 < len(runes) && escapes(quote, runes[i+1]):
+			i++
+			word = append(word, runes[i])
+			inWord = true
+		case quote == '"':
+			if r == quote {
+				quote = 0
+			} else {
+				word = append(word, r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case unicode.IsSpace(r):
+			if inWord {
+				args = append(args, string(word))
+				word, inWord = []rune{}, false
+			}
+		default:
+			word = append(word, r)
+			inWord = true
+		}
 	}
 
-	return text, nil
+	if quote != 0 {
+		return nil, fmt.Errorf("the editor command %q has an unterminated quote", command)
+	}
+	if inWord {
+		args = append(args, string(word))
+	}
+	if len(args) == 0 {
+		return nil, errors.New("please provide an editor to launch")
+	}
+	return args, nil
+}
+
+// escapes returns true if a backslash escapes the character, within the quote if any
+func escapes(quote rune, r rune) bool {
+	if quote == '"' {
+		return r == '"' || r == '\\'
+	}
+	return r == '"' || r == '\'' || r == '\\' || unicode.IsSpace(r)
 }
 
 func (e *Editor) Cleanup(val interface{}) error {
-	return e.Render(
-		EditorQuestionTemplate,
+	// the answer was taken so the next question starts over
+	e.previous = ""
+	e.refused = nil
+	return e.render(
 		EditorTemplateData{Editor: *e, Answer: "<Received>", ShowAnswer: true},
 	)
 }
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of EditorQuestionTemplate.
+func (e *Editor) render(data EditorTemplateData) error {
+	tmpl := EditorQuestionTemplate
+	if e.Template != "" {
+		tmpl = e.Template
+	}
+	return e.RenderWithFuncs(tmpl, e.TemplateFuncs, data)
+}
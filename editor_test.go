@@ -2,6 +2,13 @@ package survey
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -87,3 +94,187 @@ func TestEditorRender(t *testing.T) {
 		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
 	}
 }
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected []string
+	}{
+		{"vim", []string{"vim"}},
+		{"  code --wait ", []string{"code", "--wait"}},
+		{"emacsclient -t -a ''", []string{"emacsclient", "-t", "-a", ""}},
+		{`"/Applications/Sublime Text.app/subl" -w`, []string{"/Applications/Sublime Text.app/subl", "-w"}},
+		{`my\ editor 'it'"'"'s' "say \"hi\""`, []string{"my editor", "it's", `say "hi"`}},
+		{`C:\Windows\notepad.exe`, []string{`C:\Windows\notepad.exe`}},
+		{`"C:\Program Files\Notepad++\notepad++.exe" -multiInst`, []string{`C:\Program Files\Notepad++\notepad++.exe`, "-multiInst"}},
+	}
+
+	for _, test := range tests {
+		args, err := splitCommand(test.command)
+		assert.Nil(t, err, test.command)
+		assert.Equal(t, test.expected, args, test.command)
+	}
+
+	_, err := splitCommand(`code "--wait`)
+	assert.EqualError(t, err, `the editor command "code \"--wait" has an unterminated quote`)
+	_, err = splitCommand("  ")
+	assert.NotNil(t, err)
+}
+
+func TestEditor_command(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	// the editor writes the name of the file into it
+	prompt := &Editor{
+		Command:     `sh -c 'printf "%s" "$0" > "$0"'`,
+		FilePattern: "*.md",
+	}
+	prompt.WithStdio(terminal.Stdio{Out: bytes.NewBufferString("")})
+
+	text, err := prompt.editTempFile()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasSuffix(text, ".md"), text)
+	_, err = os.Stat(text)
+	assert.True(t, os.IsNotExist(err), "the temporary file is removed")
+}
+
+func TestEditor_file(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "survey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("name: survey\n"), 0644))
+
+	prompt := &Editor{
+		Command: `sh -c 'echo "version: 2" >> "$0"'`,
+		File:    file,
+		Default: "ignored",
+	}
+	prompt.WithStdio(terminal.Stdio{Out: bytes.NewBufferString("")})
+
+	text, err := prompt.edit(prompt.File)
+	assert.Nil(t, err)
+	assert.Equal(t, "name: survey\nversion: 2\n", text)
+}
+
+func TestEditor_comments(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "survey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	seen := filepath.Join(dir, "seen")
+
+	// the editor keeps a copy of the file it opened and adds a line
+	prompt := &Editor{
+		Command: fmt.Sprintf(`sh -c 'cp "$0" %v; echo "# Fix the parser" >> "$0"'`, seen),
+		Comment: "Explain the change.\n\nLines starting with # are ignored.",
+	}
+	prompt.WithStdio(terminal.Stdio{Out: bytes.NewBufferString("")})
+
+	text, err := prompt.editTempFile()
+	assert.Nil(t, err)
+	assert.Equal(t, "\n# Explain the change.\n#\n# Lines starting with # are ignored.\n", readEdited(t, seen))
+	// every comment line is stripped
+	assert.Equal(t, "", text)
+
+	// a refused answer is edited again with the error
+	prompt.CommentPrefix = "//"
+	prompt.Command = fmt.Sprintf(`sh -c 'cp "$0" %v; echo "more" >> "$0"'`, seen)
+	prompt.previous = "Fix the parser"
+	assert.Nil(t, prompt.Error(errors.New("too short")))
+	text, err = prompt.editTempFile()
+	assert.Nil(t, err)
+	assert.Equal(t, "Fix the parser\n\n// too short\n// Explain the change.\n//\n// Lines starting with # are ignored.\n", readEdited(t, seen))
+	assert.Equal(t, "Fix the parser\n\nmore", text)
+
+	// the next answer starts over
+	assert.Nil(t, prompt.Cleanup(text))
+	assert.Equal(t, "", prompt.previous)
+	assert.Nil(t, prompt.refused)
+}
+
+func TestEditor_refusedWithoutComment(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	prompt := &Editor{Command: `sh -c 'echo "# Title" >> "$0"'`}
+	prompt.WithStdio(terminal.Stdio{Out: bytes.NewBufferString("")})
+
+	text, err := prompt.editTempFile()
+	assert.Nil(t, err)
+	assert.Equal(t, "# Title\n", text)
+
+	// only the error is stripped so the lines of the answer that look like comments stay
+	assert.Nil(t, prompt.Error(errors.New("too short")))
+	text, err = prompt.editTempFile()
+	assert.Nil(t, err)
+	assert.Equal(t, "# Title\n\n# Title", text)
+}
+
+func TestEditor_refusedReopensRightAway(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	// nothing is ever typed, enter included
+	in, err := os.Open(os.DevNull)
+	assert.Nil(t, err)
+	defer in.Close()
+
+	prompt := &Editor{Command: `sh -c 'echo "a longer answer" >> "$0"'`}
+	prompt.WithStdio(terminal.Stdio{In: in, Out: bytes.NewBufferString("")})
+	prompt.previous = "short"
+
+	assert.Nil(t, prompt.Error(errors.New("too short")))
+	text, err := prompt.Prompt()
+	assert.Nil(t, err)
+	assert.Equal(t, "short\n\na longer answer", text)
+}
+
+func TestEditor_refusedFile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the editor is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "survey")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	assert.Nil(t, ioutil.WriteFile(file, []byte("name: survey\n"), 0644))
+
+	// nothing is ever typed, enter included
+	in, err := os.Open(os.DevNull)
+	assert.Nil(t, err)
+	defer in.Close()
+
+	prompt := &Editor{Command: `sh -c 'echo "debug: true" >> "$0"'`, File: file}
+	prompt.WithStdio(terminal.Stdio{In: in, Out: bytes.NewBufferString("")})
+
+	// the file opens again right away, as it was left
+	assert.Nil(t, prompt.Error(errors.New("debug can't be set")))
+	text, err := prompt.Prompt()
+	assert.Nil(t, err)
+	assert.Equal(t, "name: survey\ndebug: true\n", text)
+	assert.Nil(t, prompt.refused)
+
+	// the next time the prompt waits for enter again
+	_, err = prompt.Prompt()
+	assert.NotNil(t, err)
+}
+
+// readEdited returns what the editor was given to edit, without the BOM
+func readEdited(t *testing.T, file string) string {
+	raw, err := ioutil.ReadFile(file)
+	assert.Nil(t, err)
+	return string(bytes.TrimPrefix(raw, bom))
+}
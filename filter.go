@@ -0,0 +1,280 @@
+package survey
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// FilterMatch describes how an option matches what the user typed to filter the options
+// of a Select or MultiSelect.
+type FilterMatch struct {
+	// Score ranks the options matching the filter, the highest first
+	Score int
+	// Positions are the indexes of the characters of the option that matched, which are
+	// highlighted. They count runes, not bytes.
+	Positions []int
+}
+
+// OptionSegment is a part of an option that either matched the filter or not, for the
+// templates to highlight the characters that matched.
+type OptionSegment struct {
+	Text    string
+	Matched bool
+}
+
+// FuzzyFilter matches the options holding the characters of the filter in the same order
+// but not necessarily next to each other, ignoring case, so "gthb" matches "github". The
+// options where the characters follow each other, start words and are close together
+// score higher. It is the Filter of Select and MultiSelect by default.
+func FuzzyFilter(filter string, option string) *FilterMatch {
+	pattern := lowerRunes(filter)
+	text := []rune(option)
+	if len(pattern) == 0 {
+		return &FilterMatch{}
+	}
+
+	// the first character could match in several places so keep the best of them
+	var best *FilterMatch
+	for start, r := range text {
+		if unicode.ToLower(r) != pattern[0] {
+			continue
+		}
+		match := fuzzyMatchFrom(pattern, text, start)
+		if match == nil {
+			// the rest of the filter isn't found after this place, nor after the next ones
+			break
+		}
+		if best == nil || match.Score > best.Score {
+			best = match
+		}
+	}
+	return best
+}
+
+// fuzzyMatchFrom matches the pattern to the text starting with the character at start
+func fuzzyMatchFrom(pattern []rune, text []rune, start int) *FilterMatch {
+	match := &FilterMatch{}
+	for i := start; i < len(text) && len(match.Positions) < len(pattern); i++ {
+		if unicode.ToLower(text[i]) != pattern[len(match.Positions)] {
+			continue
+		}
+
+		match.Score++
+		if len(match.Positions) > 0 && match.Positions[len(match.Positions)-1] == i-1 {
+			match.Score += 4
+		}
+		if wordStart(text, i) {
+			match.Score += 3
+		}
+		match.Positions = append(match.Positions, i)
+	}
+	if len(match.Positions) < len(pattern) {
+		return nil
+	}
+
+	// every character skipped in between costs a little
+	match.Score -= match.Positions[len(match.Positions)-1] - start + 1 - len(pattern)
+	return match
+}
+
+// wordStart returns true if the character at i starts a word of the text
+func wordStart(text []rune, i int) bool {
+	if i == 0 {
+		return true
+	}
+	previous, current := text[i-1], text[i]
+	if !unicode.IsLetter(previous) && !unicode.IsDigit(previous) {
+		return true
+	}
+	// the humps of camelCase
+	return unicode.IsLower(previous) && unicode.IsUpper(current)
+}
+
+// ContainsFilter matches the options holding the filter as it was typed, ignoring case.
+// The options are kept in their order.
+func ContainsFilter(filter string, option string) *FilterMatch {
+	pattern := lowerRunes(filter)
+	text := lowerRunes(option)
+
+	for start := 0; start+len(pattern) <= len(text); start++ {
+		if string(text[start:start+len(pattern)]) != string(pattern) {
+			continue
+		}
+		match := &FilterMatch{}
+		for i := range pattern {
+			match.Positions = append(match.Positions, start+i)
+		}
+		return match
+	}
+	return nil
+}
+
+// lowerRunes returns the characters of the text in lower case, one for one
+func lowerRunes(text string) []rune {
+	runes := []rune(text)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+// filterGroupedOptions returns the options matching the filter along with their headers
+// and the positions of the characters that matched. The options are ranked by score
+// within their group so the groups stay together.
+func filterGroupedOptions(options []string, headers []string, filter string, match func(filter string, option string) *FilterMatch) ([]string, []string, [][]int) {
+	return (&filterCache{}).apply(options, headers, filter, match)
+}
+
+// filterIndexes returns the indexes of the options matching the filter in the order of the
+// list instead of by score, along with the positions of the characters that matched. Every
+// option matches an empty filter.
+func filterIndexes(options []string, filter string, match func(filter string, option string) *FilterMatch) ([]int, [][]int) {
+	indexes := []int{}
+	if filter == "" {
+		for i := range options {
+			indexes = append(indexes, i)
+		}
+		return indexes, nil
+	}
+
+	positions := [][]int{}
+	for _, result := range (&filterCache{}).match(options, make([]string, len(options)), filter, match) {
+		indexes = append(indexes, result.index)
+		positions = append(positions, result.match.Positions)
+	}
+	return indexes, positions
+}
+
+// filterResult is an option matching the filter
+type filterResult struct {
+	option string
+	header string
+	// index is the place of the option in the list and group the number of its group
+	index int
+	group int
+	match *FilterMatch
+}
+
+// filterCache remembers the options that matched the filter the last time it was applied
+// so that only the options added to the end of the list since are matched, or only the
+// ones that matched when less of the filter was typed. The list must only ever grow.
+type filterCache struct {
+	filter string
+	// count is the number of options of the list that were matched, and group the number
+	// of the group of the last one
+	count   int
+	group   int
+	results []filterResult
+}
+
+// apply is filterGroupedOptions matching as few options as possible
+func (c *filterCache) apply(options []string, headers []string, filter string, match func(filter string, option string) *FilterMatch) ([]string, []string, [][]int) {
+	if filter == "" {
+		c.filter, c.count, c.results = "", 0, nil
+		return options, headers, nil
+	}
+
+	switch {
+	case c.count > 0 && filter == c.filter && c.count <= len(options):
+		// only the options added since have to be matched
+		added := c.match(options, headers, filter, match)
+		if len(added) == 0 {
+			return c.answer()
+		}
+		c.results = append(c.results, added...)
+	case match == nil && c.count == len(options) && c.filter != "" && strings.HasPrefix(filter, c.filter):
+		// the fuzzy matches of a longer filter are among the ones of the shorter filter
+		results := []filterResult{}
+		for _, result := range c.results {
+			if m := FuzzyFilter(filter, result.option); m != nil {
+				result.match = m
+				results = append(results, result)
+			}
+		}
+		c.results = results
+	default:
+		c.count, c.group = 0, 0
+		c.results = c.match(options, headers, filter, match)
+	}
+	c.filter = filter
+
+	sort.Slice(c.results, func(i, j int) bool {
+		a, b := c.results[i], c.results[j]
+		if a.group != b.group {
+			return a.group < b.group
+		}
+		if a.match.Score != b.match.Score {
+			return a.match.Score > b.match.Score
+		}
+		return a.index < b.index
+	})
+	return c.answer()
+}
+
+// answer returns the options that matched the filter the last time along with their
+// headers and the positions of the characters that matched
+func (c *filterCache) answer() ([]string, []string, [][]int) {
+	answer := make([]string, len(c.results))
+	answerHeaders := make([]string, len(c.results))
+	positions := make([][]int, len(c.results))
+	for i, result := range c.results {
+		answer[i] = result.option
+		answerHeaders[i] = result.header
+		positions[i] = result.match.Positions
+	}
+	return answer, answerHeaders, positions
+}
+
+// match returns the options after the ones matched already that match the filter
+func (c *filterCache) match(options []string, headers []string, filter string, match func(filter string, option string) *FilterMatch) []filterResult {
+	if match == nil {
+		match = FuzzyFilter
+	}
+
+	results := []filterResult{}
+	for i := c.count; i < len(options); i++ {
+		// a new group starts with every change of header
+		if i > 0 && headers[i] != headers[i-1] {
+			c.group++
+		}
+		if m := match(filter, options[i]); m != nil {
+			results = append(results, filterResult{options[i], headers[i], i, c.group, m})
+		}
+	}
+	c.count = len(options)
+	return results
+}
+
+// pageSegments splits the entries of a page into the parts that matched the filter and
+// the ones that didn't, by the index of the entry. Entries without a match are left out.
+func pageSegments(entries []string, positions [][]int, start int) map[int][]OptionSegment {
+	page := map[int][]OptionSegment{}
+	for i, entry := range entries {
+		// there are no positions when there is no filter
+		if start+i >= len(positions) || len(positions[start+i]) == 0 {
+			continue
+		}
+		page[i] = matchSegments(entry, positions[start+i])
+	}
+	return page
+}
+
+// matchSegments splits the option into the parts at the given positions and the others
+func matchSegments(option string, positions []int) []OptionSegment {
+	matched := map[int]bool{}
+	for _, position := range positions {
+		matched[position] = true
+	}
+
+	segments := []OptionSegment{}
+	for i, r := range []rune(option) {
+		last := len(segments) - 1
+		if last >= 0 && segments[last].Matched == matched[i] {
+			segments[last].Text += string(r)
+		} else {
+			segments = append(segments, OptionSegment{Text: string(r), Matched: matched[i]})
+		}
+	}
+	return segments
+}
@@ -0,0 +1,94 @@
+package survey
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestFuzzyFilter(t *testing.T) {
+	match := FuzzyFilter("gthb", "github")
+	if assert.NotNil(t, match) {
+		assert.Equal(t, []int{0, 2, 3, 5}, match.Positions)
+	}
+
+	assert.Nil(t, FuzzyFilter("hbg", "github"), "the characters have to be in order")
+	assert.NotNil(t, FuzzyFilter("GH", "github"), "case is ignored")
+
+	// characters that follow each other or start words score higher
+	assert.True(t, FuzzyFilter("hub", "github").Score > FuzzyFilter("hub", "hello-unbound").Score)
+	assert.True(t, FuzzyFilter("gl", "git-log").Score > FuzzyFilter("gl", "gadgetly").Score)
+	// the best of the places the filter matches is kept
+	assert.Equal(t, []int{6, 7}, FuzzyFilter("lo", "hello-lock").Positions)
+}
+
+func TestContainsFilter(t *testing.T) {
+	match := ContainsFilter("Hub", "github")
+	if assert.NotNil(t, match) {
+		assert.Equal(t, []int{3, 4, 5}, match.Positions)
+	}
+	assert.Nil(t, ContainsFilter("gthb", "github"))
+}
+
+func TestFilterGroupedOptions(t *testing.T) {
+	options := []string{"gadgetly", "git-log", "gitlab", "golang"}
+	headers := []string{"", "", "tools", "tools"}
+
+	filtered, filteredHeaders, positions := filterGroupedOptions(options, headers, "gl", nil)
+	// the options are ranked within their group
+	assert.Equal(t, []string{"git-log", "gadgetly", "golang", "gitlab"}, filtered)
+	assert.Equal(t, []string{"", "", "tools", "tools"}, filteredHeaders)
+	assert.Len(t, positions, 4)
+
+	// a filter of their own decides which options match
+	prefix := func(filter string, option string) *FilterMatch {
+		if strings.HasPrefix(option, filter) {
+			return &FilterMatch{}
+		}
+		return nil
+	}
+	filtered, filteredHeaders, _ = filterGroupedOptions(options, headers, "gi", prefix)
+	assert.Equal(t, []string{"git-log", "gitlab"}, filtered)
+	assert.Equal(t, []string{"", "tools"}, filteredHeaders)
+}
+
+func TestMatchSegments(t *testing.T) {
+	assert.Equal(t, []OptionSegment{
+		{Text: "g", Matched: true},
+		{Text: "i"},
+		{Text: "th", Matched: true},
+		{Text: "u"},
+		{Text: "b", Matched: true},
+	}, matchSegments("github", []int{0, 2, 3, 5}))
+}
+
+func TestSelect_fuzzyFilter(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Select{
+		Message: "Pick a repository:",
+		Options: []string{"gadgetly", "homebrew", "git-log", "github"},
+	}
+	prompt.selectedIndex = 3
+
+	for _, key := range "gthb" {
+		prompt.OnChange(nil, 0, key)
+	}
+	data := prompt.questionData()
+	assert.Equal(t, []string{"github"}, data.PageEntries)
+	// the selection moves to the best match as the results change
+	assert.Equal(t, 0, data.SelectedIndex)
+	assert.Equal(t, matchSegments("github", []int{0, 2, 3, 5}), data.PageMatches[0])
+
+	prompt.OnChange(nil, 0, terminal.KeyBackspace)
+	prompt.OnChange(nil, 0, terminal.KeyBackspace)
+	data = prompt.questionData()
+	// options that match as well keep their order
+	assert.Equal(t, []string{"git-log", "github", "gadgetly"}, data.PageEntries)
+	line, _, _ := prompt.OnChange(nil, 0, terminal.KeyArrowDown)
+	assert.Equal(t, "github", string(line))
+}
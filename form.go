@@ -0,0 +1,580 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Form is a prompt that shows several fields at once. Every field is a Question holding an
+Input, Password, Confirm or Select prompt, which is used for its Message, Default and
+Options, along with its Name, Validate and Transform. Tab and the down arrow move to the
+next field, shift+tab and the up arrow to the previous one, and enter on the submit row
+validates every field and sends the form. Invalid fields show their error right below
+them. Response type is a core.Answers holding every answer by the name of its field,
+which is written to the matching fields of a struct or keys of a map.
+
+	login := struct {
+		Host     string
+		User     string
+		Password string
+	}{}
+	prompt := &survey.Form{
+		Message: "Log in",
+		Fields: []*survey.Question{
+			{Name: "host", Prompt: &survey.Input{Message: "Host:"}, Validate: survey.Required},
+			{Name: "user", Prompt: &survey.Input{Message: "User:"}},
+			{Name: "password", Prompt: &survey.Password{Message: "Password:"}},
+		},
+	}
+	survey.AskOne(prompt, &login, nil)
+*/
+type Form struct {
+	core.Renderer
+	Message       string
+	Fields        []*Question
+	SubmitLabel   string
+	Help          string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	fields        []formField
+	active        int
+	showingHelp   bool
+}
+
+// formField is what was entered in a field of a form
+type formField struct {
+	text   []rune
+	on     bool
+	option int
+	err    error
+}
+
+// the kinds of fields a form can hold
+const (
+	formInput    = "input"
+	formPassword = "password"
+	formConfirm  = "confirm"
+	formSelect   = "select"
+)
+
+// FormRow is a field of a Form as it is shown.
+type FormRow struct {
+	Name  string
+	Label string
+	// Kind is one of "input", "password", "confirm" or "select"
+	Kind string
+	// Value is what the field holds as it is shown, passwords are masked
+	Value   string
+	Options []string
+	Error   string
+	Active  bool
+}
+
+// the data available to the templates when processing
+type FormTemplateData struct {
+	Form
+	Rows         []FormRow
+	SubmitActive bool
+	ShowMessage  bool
+	Answer       string
+	ShowAnswer   bool
+	ShowHelp     bool
+}
+
+// FormQuestionTemplate draws a line for every field, followed by the line of its error
+// if it has one, and ends with the submit row. The cursor is put at the end of the line
+// of the active field.
+var FormQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use tab to move, enter on {{ .SubmitLabel }} to send{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range .Rows}}
+    {{- if .Active}}{{color "cyan"}}{{ SelectFocusIcon }} {{color "reset"}}{{else}}  {{end}}
+    {{- color "default+hb"}}{{ .Label }}{{color "reset"}}{{" "}}
+    {{- if eq .Kind "select"}}{{color "cyan"}}‹ {{ .Value }} ›{{color "reset"}}
+    {{- else if eq .Kind "confirm"}}{{color "cyan"}}{{ .Value }}{{color "reset"}}
+    {{- else}}{{ .Value }}{{end}}{{"\n"}}
+    {{- if .Error}}{{"    "}}{{color "red"}}{{ ErrorIcon }} {{ .Error }}{{color "reset"}}{{"\n"}}{{end}}
+  {{- end}}
+  {{- if .SubmitActive}}{{color "cyan+b"}}{{ SelectFocusIcon }} {{else}}{{color "default+hb"}}  {{end}}
+  {{- "[ "}}{{ .SubmitLabel }}{{" ]"}}{{color "reset"}}{{"\n"}}
+{{- end}}`
+
+// FormAccessibleQuestionTemplate is used instead of FormQuestionTemplate when
+// core.Accessible is set. The fields are asked one after the other, each on its own
+// line, and the options of a select field are only printed the first time it is asked.
+var FormAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .ShowMessage}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- end}}
+{{- range .Rows}}
+  {{- color "default+hb"}}{{ .Label }}{{color "reset"}}
+  {{- if .Options}}{{"\n"}}
+    {{- range $ix, $option := .Options}}{{"  "}}{{ inc $ix }}. {{ $option }}{{"\n"}}{{end}}
+  {{- end}}
+  {{- if eq .Kind "select"}} {{color "cyan"}}Type a number or name{{color "reset"}}
+  {{- else if eq .Kind "confirm"}} {{color "cyan"}}Type yes or no{{color "reset"}}{{end}}
+  {{- if and .Value (ne .Kind "password")}} {{color "white"}}(default: {{ .Value }}){{color "reset"}}{{end}}
+  {{- if and $.Help (not $.ShowHelp)}} {{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}}{{end}}{{" "}}
+{{- end}}`
+
+// kind returns the kind of the field at the given index
+func (f *Form) kind(i int) string {
+	switch f.Fields[i].Prompt.(type) {
+	case *Input:
+		return formInput
+	case *Password:
+		return formPassword
+	case *Confirm:
+		return formConfirm
+	case *Select:
+		return formSelect
+	}
+	return ""
+}
+
+// label returns the label of the field at the given index
+func (f *Form) label(i int) string {
+	switch prompt := f.Fields[i].Prompt.(type) {
+	case *Input:
+		return prompt.Message
+	case *Password:
+		return prompt.Message
+	case *Confirm:
+		return prompt.Message
+	case *Select:
+		return prompt.Message
+	}
+	return f.Fields[i].Name
+}
+
+// options returns the options of the field at the given index, if it is a select
+func (f *Form) options(i int) []string {
+	if prompt, ok := f.Fields[i].Prompt.(*Select); ok {
+		return prompt.Options
+	}
+	return nil
+}
+
+// reset fills the fields with their defaults
+func (f *Form) reset() {
+	f.fields = make([]formField, len(f.Fields))
+	for i, q := range f.Fields {
+		switch prompt := q.Prompt.(type) {
+		case *Input:
+			f.fields[i].text = []rune(prompt.Default)
+		case *Confirm:
+			f.fields[i].on = prompt.Default
+		case *Select:
+			for j, option := range prompt.Options {
+				if option == prompt.Default {
+					f.fields[i].option = j
+				}
+			}
+		}
+	}
+	f.active = 0
+}
+
+// value returns the answer of the field at the given index
+func (f *Form) value(i int) interface{} {
+	switch f.kind(i) {
+	case formConfirm:
+		return f.fields[i].on
+	case formSelect:
+		if options := f.options(i); f.fields[i].option < len(options) {
+			return options[f.fields[i].option]
+		}
+		return ""
+	}
+	return string(f.fields[i].text)
+}
+
+// validate checks the field at the given index, keeping its error to show next to it
+func (f *Form) validate(i int) error {
+	f.fields[i].err = nil
+	if f.Fields[i].Validate != nil {
+		f.fields[i].err = f.Fields[i].Validate(f.value(i))
+	}
+	return f.fields[i].err
+}
+
+// submitRow returns the index of the submit row, which comes after the fields
+func (f *Form) submitRow() int {
+	return len(f.Fields)
+}
+
+// move goes to another field, checking the one that is left
+func (f *Form) move(step int) {
+	if f.active < f.submitRow() {
+		f.validate(f.active)
+	}
+	f.active = (f.active + step + len(f.Fields) + 1) % (len(f.Fields) + 1)
+}
+
+// OnChange is called on every keypress.
+func (f *Form) OnChange(key rune) {
+	// the keys moving between the fields work everywhere
+	switch key {
+	case '\t', terminal.KeyArrowDown:
+		f.move(1)
+		return
+	case terminal.KeyShiftTab, terminal.KeyArrowUp:
+		f.move(-1)
+		return
+	case '\r', '\n':
+		if f.active < f.submitRow() {
+			f.move(1)
+		}
+		return
+	}
+
+	if f.active == f.submitRow() {
+		if key == f.Config().HelpInputRune && f.Help != "" {
+			f.showingHelp = true
+		}
+		return
+	}
+	field := &f.fields[f.active]
+
+	switch f.kind(f.active) {
+	case formConfirm:
+		switch {
+		case key == terminal.KeySpace || key == terminal.KeyArrowLeft || key == terminal.KeyArrowRight:
+			field.on = !field.on
+		case key == 'y' || key == 'Y':
+			field.on = true
+		case key == 'n' || key == 'N':
+			field.on = false
+		case key == f.Config().HelpInputRune && f.Help != "":
+			f.showingHelp = true
+		}
+	case formSelect:
+		count := len(f.options(f.active))
+		switch {
+		case count == 0:
+		case key == terminal.KeySpace || key == terminal.KeyArrowRight:
+			field.option = (field.option + 1) % count
+		case key == terminal.KeyArrowLeft:
+			field.option = (field.option + count - 1) % count
+		case key == f.Config().HelpInputRune && f.Help != "":
+			f.showingHelp = true
+		}
+	default:
+		switch {
+		case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+			if len(field.text) > 0 {
+				field.text = field.text[:len(field.text)-1]
+			}
+		case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+			field.text = []rune{}
+		case terminal.IsPrintable(key):
+			field.text = append(field.text, key)
+		}
+	}
+}
+
+// submit checks every field, moving to the first invalid one. It returns the answers if
+// they are all valid.
+func (f *Form) submit() (core.Answers, bool) {
+	valid := true
+	for i := len(f.Fields) - 1; i >= 0; i-- {
+		if f.validate(i) != nil {
+			valid = false
+			f.active = i
+		}
+	}
+	if !valid {
+		return nil, false
+	}
+
+	answers := core.Answers{}
+	for i, q := range f.Fields {
+		answers[q.Name] = f.value(i)
+		if q.Transform != nil {
+			if newAns := q.Transform(answers[q.Name]); newAns != nil {
+				answers[q.Name] = newAns
+			}
+		}
+	}
+	return answers, true
+}
+
+func (f *Form) Prompt() (interface{}, error) {
+	if len(f.Fields) == 0 {
+		return nil, errors.New("please provide fields to fill in")
+	}
+	for i, q := range f.Fields {
+		if f.kind(i) == "" {
+			return nil, fmt.Errorf("field %q is not an Input, Password, Confirm or Select", q.Name)
+		}
+	}
+
+	// what was typed is kept when the form is asked again after failing validation
+	if len(f.fields) != len(f.Fields) {
+		f.reset()
+	}
+	f.showingHelp = false
+
+	rr := f.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts ask the fields one after the other
+	if f.Config().Accessible {
+		return f.accessiblePrompt(rr)
+	}
+
+	err := f.redraw()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			f.RestoreCursor()
+			return nil, terminal.InterruptErr
+		}
+		// enter only sends the form from the submit row, ctrl+d sends it from anywhere
+		submit := (r == '\r' || r == '\n') && f.active == f.submitRow()
+		if submit || r == terminal.KeyEndTransmission {
+			if answers, ok := f.submit(); ok {
+				// leave the cursor at the bottom of the prompt for whatever comes next
+				f.RestoreCursor()
+				return answers, nil
+			}
+		} else {
+			f.OnChange(r)
+		}
+
+		err = f.redraw()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (f *Form) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	for i := range f.Fields {
+		f.active = i
+		data := f.questionData()
+		data.ShowMessage = i == 0
+		data.Rows = data.Rows[i : i+1]
+		data.Rows[0].Options = f.options(i)
+
+		for {
+			err := f.RenderWithFuncs(FormAccessibleQuestionTemplate, f.TemplateFuncs, data)
+			if err != nil {
+				return nil, err
+			}
+
+			echo := rune(0)
+			if f.kind(i) == formPassword {
+				echo = '*'
+			}
+			line, err := rr.ReadLine(echo)
+			if err != nil {
+				return nil, err
+			}
+
+			// ask again without repeating the message and options
+			data.ShowMessage = false
+			data.ShowHelp = false
+			data.Rows[0].Options = nil
+
+			val := strings.TrimSpace(string(line))
+			if val == string(f.Config().HelpInputRune) && f.Help != "" {
+				data.ShowHelp = true
+				continue
+			}
+			err = f.set(i, val)
+			if err == nil {
+				err = f.validate(i)
+			}
+			if err == nil {
+				break
+			}
+			// we didn't get a valid answer, so print error and ask again
+			if err := f.Error(err); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	answers, _ := f.submit()
+	return answers, nil
+}
+
+// set fills in the field at the given index with what was typed in accessible mode,
+// keeping the default when nothing was typed
+func (f *Form) set(i int, val string) error {
+	field := &f.fields[i]
+
+	switch f.kind(i) {
+	case formConfirm:
+		switch {
+		case val == "":
+		case yesRx.MatchString(val):
+			field.on = true
+		case noRx.MatchString(val):
+			field.on = false
+		default:
+			return fmt.Errorf("%q is not yes or no, please try again.", val)
+		}
+	case formSelect:
+		if val == "" {
+			return nil
+		}
+		options := f.options(i)
+		choice, err := accessibleChoice(options, val)
+		if err != nil {
+			return err
+		}
+		for j, option := range options {
+			if option == choice {
+				field.option = j
+			}
+		}
+	case formInput:
+		if val != "" {
+			field.text = []rune(val)
+		}
+	default:
+		field.text = []rune(val)
+	}
+	return nil
+}
+
+// redraw renders the form and moves the cursor to the end of the active row
+func (f *Form) redraw() error {
+	// the lines below the active row, counting the empty one after the last newline
+	up := 1
+	for i := len(f.Fields) - 1; i >= f.active; i-- {
+		if f.fields[i].err != nil {
+			up++
+		}
+		if i > f.active {
+			up++
+		}
+	}
+	if f.active < f.submitRow() {
+		up++
+	}
+
+	return f.RenderWithCursor(f.template(), f.TemplateFuncs, f.questionData(), up, 0)
+}
+
+func (f *Form) Cleanup(val interface{}) error {
+	answers, _ := val.(core.Answers)
+
+	summary := []string{}
+	for i, q := range f.Fields {
+		answer := fmt.Sprint(answers[q.Name])
+		switch f.kind(i) {
+		case formPassword:
+			answer = f.passwordMask(i, []rune(answer), false)
+		case formConfirm:
+			if on, ok := answers[q.Name].(bool); ok {
+				answer = yesNo(on)
+			}
+		}
+		summary = append(summary, f.label(i)+" "+answer)
+	}
+	// start from the defaults the next time the form is asked
+	f.fields = nil
+
+	return f.render(FormTemplateData{
+		Form:       *f,
+		Answer:     strings.Join(summary, ", "),
+		ShowAnswer: true,
+	})
+}
+
+// passwordMask returns how the password of a field is shown. Only the field being typed in
+// shows a mask character for every character typed, the others show a fixed number of them
+// so the length of the password isn't given away. NoEcho shows nothing at all.
+func (f *Form) passwordMask(i int, text []rune, typing bool) string {
+	password := f.Fields[i].Prompt.(*Password)
+	mask := string(password.maskRune())
+	switch {
+	case password.NoEcho:
+		return ""
+	case typing:
+		return strings.Repeat(mask, len(text))
+	case len(text) == 0:
+		return ""
+	}
+	return strings.Repeat(mask, 8)
+}
+
+// questionData returns the data to render the fields as they are
+func (f *Form) questionData() FormTemplateData {
+	rows := make([]FormRow, len(f.Fields))
+	for i, q := range f.Fields {
+		value := fmt.Sprint(f.value(i))
+		switch f.kind(i) {
+		case formPassword:
+			value = f.passwordMask(i, f.fields[i].text, i == f.active)
+		case formConfirm:
+			value = yesNo(f.fields[i].on)
+		}
+
+		rows[i] = FormRow{
+			Name:   q.Name,
+			Label:  f.label(i),
+			Kind:   f.kind(i),
+			Value:  value,
+			Active: i == f.active,
+		}
+		if f.fields[i].err != nil {
+			rows[i].Error = f.fields[i].err.Error()
+		}
+	}
+
+	form := *f
+	form.SubmitLabel = f.submitLabel()
+
+	return FormTemplateData{
+		Form:         form,
+		Rows:         rows,
+		SubmitActive: f.active == f.submitRow(),
+		ShowHelp:     f.showingHelp,
+	}
+}
+
+// submitLabel returns the label of the submit row
+func (f *Form) submitLabel() string {
+	if f.SubmitLabel == "" {
+		return "Submit"
+	}
+	return f.SubmitLabel
+}
+
+// template returns the Template of the prompt, or FormQuestionTemplate if it has none
+func (f *Form) template() string {
+	if f.Template != "" {
+		return f.Template
+	}
+	return FormQuestionTemplate
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of FormQuestionTemplate.
+func (f *Form) render(data FormTemplateData) error {
+	return f.RenderWithFuncs(f.template(), f.TemplateFuncs, data)
+}
@@ -0,0 +1,215 @@
+package survey
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+// loginForm returns a form ready to take keys without asking it
+func loginForm() *Form {
+	form := &Form{
+		Message: "Log in",
+		Fields: []*Question{
+			{Name: "host", Prompt: &Input{Message: "Host:", Default: "localhost"}},
+			{Name: "user", Prompt: &Input{Message: "User:"}, Validate: Required},
+			{Name: "password", Prompt: &Password{Message: "Password:"}},
+			{Name: "remember", Prompt: &Confirm{Message: "Remember me?"}},
+			{Name: "region", Prompt: &Select{Message: "Region:", Options: []string{"us", "eu"}, Default: "eu"}},
+		},
+	}
+	form.reset()
+	return form
+}
+
+func TestFormRender(t *testing.T) {
+	form := loginForm()
+	form.fields[2].text = []rune("hunter2")
+	form.active = 1
+	form.validate(1)
+	submitting := loginForm()
+	submitting.active = submitting.submitRow()
+	submitting.SubmitLabel = "Log in"
+
+	tests := []struct {
+		title    string
+		data     FormTemplateData
+		expected string
+	}{
+		{
+			"Test Form question output with an error",
+			form.questionData(),
+			`? Log in  [Use tab to move, enter on Submit to send]
+  Host: localhost
+❯ User: 
+    ✘ Value is required
+  Password: ********
+  Remember me? No
+  Region: ‹ eu ›
+  [ Submit ]
+`,
+		},
+		{
+			"Test Form question output on the submit row",
+			submitting.questionData(),
+			`? Log in  [Use tab to move, enter on Log in to send]
+  Host: localhost
+  User: 
+  Password: 
+  Remember me? No
+  Region: ‹ eu ›
+❯ [ Log in ]
+`,
+		},
+		{
+			"Test Form answer output",
+			FormTemplateData{Form: *form, Answer: "Host: localhost, User: admin", ShowAnswer: true},
+			"? Log in Host: localhost, User: admin\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		err := form.Render(
+			FormQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestForm_moveBetweenFields(t *testing.T) {
+	form := loginForm()
+
+	form.OnChange('\t')
+	assert.Equal(t, 1, form.active)
+
+	// leaving a field checks it
+	form.OnChange(terminal.KeyShiftTab)
+	assert.Equal(t, 0, form.active)
+	assert.NotNil(t, form.fields[1].err)
+
+	// enter goes to the next field
+	form.OnChange('\r')
+	for _, key := range "admin" {
+		form.OnChange(key)
+	}
+	form.OnChange('\r')
+	assert.Nil(t, form.fields[1].err)
+	assert.Equal(t, 2, form.active)
+
+	// moving wraps around through the submit row
+	form.OnChange(terminal.KeyShiftTab)
+	form.OnChange(terminal.KeyShiftTab)
+	form.OnChange(terminal.KeyShiftTab)
+	assert.Equal(t, form.submitRow(), form.active)
+	form.OnChange('\t')
+	assert.Equal(t, 0, form.active)
+	// ctrl+^ isn't taken for shift+tab
+	form.OnChange('\x1e')
+	assert.Equal(t, 0, form.active)
+}
+
+func TestForm_confirmAndSelectFields(t *testing.T) {
+	form := loginForm()
+
+	form.active = 3
+	form.OnChange(terminal.KeySpace)
+	assert.Equal(t, true, form.value(3))
+	form.OnChange('n')
+	assert.Equal(t, false, form.value(3))
+
+	form.active = 4
+	form.OnChange(terminal.KeyArrowRight)
+	assert.Equal(t, "us", form.value(4))
+	form.OnChange(terminal.KeyArrowLeft)
+	assert.Equal(t, "eu", form.value(4))
+}
+
+func TestForm_submit(t *testing.T) {
+	form := loginForm()
+	form.Fields[0].Transform = ToLower
+	form.Fields[0].Validate = func(ans interface{}) error {
+		if ans == "" {
+			return errors.New("need a host")
+		}
+		return nil
+	}
+	form.fields[0].text = []rune("")
+	form.active = form.submitRow()
+
+	// the first invalid field gets the focus
+	_, ok := form.submit()
+	assert.False(t, ok)
+	assert.Equal(t, 0, form.active)
+	assert.Equal(t, "need a host", form.questionData().Rows[0].Error)
+	assert.Equal(t, "Value is required", form.questionData().Rows[1].Error)
+
+	form.fields[0].text = []rune("DB.local")
+	form.fields[1].text = []rune("admin")
+	answers, ok := form.submit()
+	assert.True(t, ok)
+	assert.Equal(t, core.Answers{
+		"host":     "db.local",
+		"user":     "admin",
+		"password": "",
+		"remember": false,
+		"region":   "eu",
+	}, answers)
+}
+
+func TestForm_passwordMask(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	form := loginForm()
+	form.fields[2].text = []rune("hunter2")
+	// the password is masked character by character only while it is typed
+	form.active = 2
+	assert.Equal(t, "*******", form.questionData().Rows[2].Value)
+
+	err := form.Cleanup(core.Answers{
+		"host":     "localhost",
+		"user":     "admin",
+		"password": "hunter2",
+		"remember": true,
+		"region":   "eu",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "? Log in Host: localhost, User: admin, Password: ********, Remember me? Yes, Region: eu\n", outputBuffer.String())
+}
+
+func TestForm_passwordNoEcho(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	form := loginForm()
+	form.Fields[2].Prompt.(*Password).NoEcho = true
+	form.fields[2].text = []rune("hunter2")
+	form.active = 2
+	assert.Equal(t, "", form.questionData().Rows[2].Value)
+
+	err := form.Cleanup(core.Answers{"host": "localhost", "user": "admin", "password": "hunter2", "remember": false, "region": "eu"})
+	assert.Nil(t, err)
+	assert.Equal(t, "? Log in Host: localhost, User: admin, Password: , Remember me? No, Region: eu\n", outputBuffer.String())
+}
+
+func TestForm_unsupportedField(t *testing.T) {
+	form := &Form{Fields: []*Question{{Name: "notes", Prompt: &Editor{Message: "Notes:"}}}}
+
+	_, err := form.Prompt()
+	assert.NotNil(t, err)
+}
@@ -1,7 +1,8 @@
 package survey
 
 import (
-	"os"
+	"errors"
+	"fmt"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
@@ -14,20 +15,58 @@ and accepts the input with the enter key. Response type is a string.
 	name := ""
 	prompt := &survey.Input{ Message: "What is your name?" }
 	survey.AskOne(prompt, &name, nil)
+
+If Suggest is set, tab completes the text with the common prefix of the suggestions it
+returns for the text typed so far and shows them in a list below the input. Pressing tab
+again or using the arrow keys goes through the list.
+
+	host := ""
+	prompt := &survey.Input{
+		Message: "Which host?",
+		Suggest: func(toComplete string) []string {
+			return knownHosts(toComplete)
+		},
+	}
+	survey.AskOne(prompt, &host, nil)
+
+If Format is set, only the characters fitting it can be typed and its literals are filled
+in on their own, see terminal.Format. The answer keeps the literals unless Raw is set.
+A Default has to fit the Format, and Format can't be used along with Suggest.
+
+	phone := ""
+	prompt := &survey.Input{
+		Message: "Phone number:",
+		Format:  "(999) 999-9999",
+	}
+	survey.AskOne(prompt, &phone, nil)
 */
 type Input struct {
 	core.Renderer
-	Message string
-	Default string
-	Help    string
+	Message       string
+	Default       string
+	Help          string
+	Suggest       func(toComplete string) []string
+	PageSize      int
+	Format        string
+	Raw           bool
+	Template      string
+	TemplateFuncs map[string]interface{}
+	answer        []rune
+	index         int
+	suggestions   []string
+	selectedIndex int
+	showingHelp   bool
 }
 
 // data available to the templates when processing
 type InputTemplateData struct {
 	Input
-	Answer     string
-	ShowAnswer bool
-	ShowHelp   bool
+	Value         string
+	PageEntries   []string
+	SelectedIndex int
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
 }
 
 // Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
@@ -39,13 +78,37 @@ var InputQuestionTemplate = `
   {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
   {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
+  {{- if .Format}}{{color "cyan"}}[{{.Format}}]{{color "reset"}} {{end}}
   {{- if .Default}}{{color "white"}}({{.Default}}) {{color "reset"}}{{end}}
+  {{- .Value}}
+  {{- if .PageEntries}}{{"\n"}}
+    {{- range $ix, $choice := .PageEntries}}
+      {{- if eq $ix $.SelectedIndex}}{{color "cyan+b"}}{{ SelectFocusIcon }} {{else}}{{color "default+hb"}}  {{end}}
+      {{- $choice}}
+      {{- color "reset"}}{{"\n"}}
+    {{- end}}
+  {{- end}}
 {{- end}}`
 
 func (i *Input) Prompt() (interface{}, error) {
+	format := terminal.Format(i.Format)
+	if format != "" {
+		// the suggestions are typed into the line as they are
+		if i.Suggest != nil {
+			return "", errors.New("Suggest can't be used along with a Format")
+		}
+		if i.Default != "" && !format.Complete([]rune(i.Default)) {
+			return "", fmt.Errorf("the Default %q doesn't fit %v", i.Default, i.Format)
+		}
+	}
+
+	// the suggestions are drawn under the line so the whole prompt is redrawn as the user types
+	if i.Suggest != nil && !i.Config().Accessible {
+		return i.suggestPrompt()
+	}
+
 	// render the template
-	err := i.Render(
-		InputQuestionTemplate,
+	err := i.render(
 		InputTemplateData{Input: *i},
 	)
 	if err != nil {
@@ -53,46 +116,254 @@ func (i *Input) Prompt() (interface{}, error) {
 	}
 
 	// start reading runes from the standard in
-	rr := terminal.NewRuneReader(os.Stdin)
+	rr := i.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 
 	line := []rune{}
+	showHelp := false
 	// get the next line
 	for {
-		line, err = rr.ReadLine(0)
+		if format != "" {
+			line, err = rr.ReadFormattedLine(format)
+		} else {
+			line, err = rr.ReadLine(0)
+		}
 		if err != nil {
 			return string(line), err
 		}
 		// terminal will echo the \n so we need to jump back up one row
-		terminal.CursorPreviousLine(1)
+		if !i.Config().Accessible {
+			i.NewCursor().PreviousLine(1)
+		}
 
-		if string(line) == string(core.HelpInputRune) && i.Help != "" {
-			err = i.Render(
-				InputQuestionTemplate,
-				InputTemplateData{Input: *i, ShowHelp: true},
-			)
+		if string(line) == string(i.Config().HelpInputRune) && i.Help != "" {
+			showHelp = true
+		} else if format != "" && len(line) > 0 && !format.Complete(line) {
+			// we didn't get a valid answer, so print error and prompt again
+			err = i.Error(fmt.Errorf("%q doesn't fit %v, please try again.", string(line), i.Format))
 			if err != nil {
 				return "", err
 			}
-			continue
+		} else {
+			break
+		}
+
+		err = i.render(
+			InputTemplateData{Input: *i, ShowHelp: showHelp},
+		)
+		if err != nil {
+			return "", err
 		}
-		break
 	}
 
 	// if the line is empty
 	if line == nil || len(line) == 0 {
-		// use the default value
-		return i.Default, err
+		// use the default value, with the literals of the format it may have been given without
+		line = []rune(i.Default)
+		if format != "" {
+			line = format.Apply(line)
+		}
+	}
+
+	// leave the literals of the format out if only the raw value is wanted
+	if format != "" && i.Raw {
+		return string(format.Raw(line)), err
 	}
 
 	// we're done
 	return string(line), err
 }
 
+func (i *Input) suggestPrompt() (interface{}, error) {
+	i.answer = []rune{}
+	i.index = 0
+	i.suggestions = nil
+	i.showingHelp = false
+
+	rr := i.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	err := i.redraw()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		if r == terminal.KeyInterrupt {
+			i.RestoreCursor()
+			return "", terminal.InterruptErr
+		}
+
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			switch {
+			// enter picks the suggestion and closes the list
+			case len(i.suggestions) > 0 && r != terminal.KeyEndTransmission:
+				i.suggestions = nil
+			case string(i.answer) == string(i.Config().HelpInputRune) && i.Help != "":
+				i.showingHelp = true
+				i.answer = []rune{}
+				i.index = 0
+			default:
+				// leave the cursor under the prompt for whatever comes next
+				i.RestoreCursor()
+				if len(i.answer) == 0 {
+					return i.Default, nil
+				}
+				return string(i.answer), nil
+			}
+		} else {
+			i.OnChange(r)
+		}
+
+		err = i.redraw()
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// OnChange is called on every keypress when the input has suggestions.
+func (i *Input) OnChange(key rune) {
+	switch {
+	case key == '\t':
+		i.complete()
+	case key == terminal.KeyArrowUp && len(i.suggestions) > 0:
+		i.selectSuggestion(i.selectedIndex - 1)
+	case key == terminal.KeyArrowDown && len(i.suggestions) > 0:
+		i.selectSuggestion(i.selectedIndex + 1)
+	case key == terminal.KeyEscape:
+		i.suggestions = nil
+	case key == terminal.KeyArrowLeft:
+		if i.index > 0 {
+			i.index--
+		}
+	case key == terminal.KeyArrowRight:
+		if i.index < len(i.answer) {
+			i.index++
+		}
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if i.index > 0 {
+			i.answer = append(i.answer[:i.index-1], i.answer[i.index:]...)
+			i.index--
+		}
+		i.suggestions = nil
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		i.answer = []rune{}
+		i.index = 0
+		i.suggestions = nil
+	case terminal.IsPrintable(key):
+		i.answer = append(i.answer[:i.index], append([]rune{key}, i.answer[i.index:]...)...)
+		i.index++
+		// the suggestions don't match the text anymore
+		i.suggestions = nil
+	}
+}
+
+// complete fills in the common prefix of the suggestions for the text, or goes to the
+// next suggestion if they are already shown
+func (i *Input) complete() {
+	if len(i.suggestions) > 0 {
+		i.selectSuggestion(i.selectedIndex + 1)
+		return
+	}
+
+	suggestions := i.Suggest(string(i.answer))
+	switch len(suggestions) {
+	case 0:
+		return
+	case 1:
+		// there is nothing to choose from
+		i.setAnswer(suggestions[0])
+		return
+	}
+
+	if prefix := commonPrefix(suggestions); len([]rune(prefix)) > len(i.answer) {
+		i.setAnswer(prefix)
+	}
+	i.suggestions = suggestions
+	// nothing is selected until the user goes through the list
+	i.selectedIndex = -1
+}
+
+// selectSuggestion highlights a suggestion, wrapping around the list, and puts it in the input
+func (i *Input) selectSuggestion(index int) {
+	if index < 0 {
+		index = len(i.suggestions) - 1
+	} else if index >= len(i.suggestions) {
+		index = 0
+	}
+	i.selectedIndex = index
+	i.setAnswer(i.suggestions[index])
+}
+
+// setAnswer replaces the text and puts the cursor at its end
+func (i *Input) setAnswer(answer string) {
+	i.answer = []rune(answer)
+	i.index = len(i.answer)
+}
+
+// commonPrefix returns the longest string all of the given ones start with
+func commonPrefix(values []string) string {
+	prefix := []rune(values[0])
+	for _, value := range values[1:] {
+		runes := []rune(value)
+		n := 0
+		for n < len(prefix) && n < len(runes) && prefix[n] == runes[n] {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return string(prefix)
+}
+
+// redraw renders the text along with the suggestions and moves the cursor to where the user
+// is typing
+func (i *Input) redraw() error {
+	opts, idx := paginate(pageSize(i.PageSize, i.Config()), i.suggestions, i.selectedIndex)
+
+	// the text is on the last line, unless the suggestions are shown below it
+	up := 0
+	if len(opts) > 0 {
+		up = len(opts) + 1
+	}
+
+	return i.RenderWithCursor(
+		i.template(),
+		i.TemplateFuncs,
+		InputTemplateData{
+			Input:         *i,
+			Value:         string(i.answer),
+			PageEntries:   opts,
+			SelectedIndex: idx,
+			ShowHelp:      i.showingHelp,
+		},
+		up,
+		len(i.answer)-i.index,
+	)
+}
+
 func (i *Input) Cleanup(val interface{}) error {
-	return i.Render(
-		InputQuestionTemplate,
+	return i.render(
 		InputTemplateData{Input: *i, Answer: val.(string), ShowAnswer: true},
 	)
 }
+
+// template returns the Template of the prompt, or InputQuestionTemplate if it has none
+func (i *Input) template() string {
+	if i.Template != "" {
+		return i.Template
+	}
+	return InputQuestionTemplate
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of InputQuestionTemplate.
+func (i *Input) render(data InputTemplateData) error {
+	return i.RenderWithFuncs(i.template(), i.TemplateFuncs, data)
+}
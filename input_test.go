@@ -2,6 +2,7 @@ package survey
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,6 +67,18 @@ func TestInputRender(t *testing.T) {
 			`ⓘ This is helpful
 ? What is your favorite month: (April) `,
 		},
+		{
+			"Test Input question output with suggestions",
+			Input{Message: "What is your favorite month:"},
+			InputTemplateData{Value: "Ju", PageEntries: []string{"June", "July"}, SelectedIndex: 1},
+			"? What is your favorite month: Ju\n  June\n❯ July\n",
+		},
+		{
+			"Test Input question output with format",
+			Input{Message: "Phone number:", Format: "(999) 999-9999"},
+			InputTemplateData{},
+			"? Phone number: [(999) 999-9999] ",
+		},
 	}
 
 	outputBuffer := bytes.NewBufferString("")
@@ -82,3 +95,112 @@ func TestInputRender(t *testing.T) {
 		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
 	}
 }
+
+func TestInput_suggestions(t *testing.T) {
+	months := []string{"January", "June", "July"}
+	prompt := &Input{
+		Suggest: func(toComplete string) []string {
+			suggestions := []string{}
+			for _, month := range months {
+				if strings.HasPrefix(month, toComplete) {
+					suggestions = append(suggestions, month)
+				}
+			}
+			return suggestions
+		},
+	}
+
+	// tab completes the common prefix and shows the suggestions
+	prompt.OnChange('J')
+	prompt.OnChange('\t')
+	assert.Equal(t, "J", string(prompt.answer))
+	assert.Equal(t, months, prompt.suggestions)
+
+	// typing hides them again
+	prompt.OnChange('u')
+	assert.Nil(t, prompt.suggestions)
+
+	prompt.OnChange('\t')
+	assert.Equal(t, "Ju", string(prompt.answer))
+	assert.Equal(t, []string{"June", "July"}, prompt.suggestions)
+
+	// tab and the arrows go through the list
+	prompt.OnChange('\t')
+	assert.Equal(t, "June", string(prompt.answer))
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, "July", string(prompt.answer))
+	prompt.OnChange('\t')
+	assert.Equal(t, "June", string(prompt.answer))
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, "July", string(prompt.answer))
+
+	// a single suggestion is completed right away
+	prompt.OnChange(terminal.KeyDeleteLine)
+	for _, key := range "Jan" {
+		prompt.OnChange(key)
+	}
+	prompt.OnChange('\t')
+	assert.Equal(t, "January", string(prompt.answer))
+	assert.Nil(t, prompt.suggestions)
+}
+
+func TestInput_editing(t *testing.T) {
+	prompt := &Input{Suggest: func(string) []string { return nil }}
+
+	for _, key := range "ac" {
+		prompt.OnChange(key)
+	}
+	prompt.OnChange(terminal.KeyArrowLeft)
+	prompt.OnChange('b')
+	assert.Equal(t, "abc", string(prompt.answer))
+	assert.Equal(t, 2, prompt.index)
+
+	prompt.OnChange(terminal.KeyBackspace)
+	assert.Equal(t, "ac", string(prompt.answer))
+	assert.Equal(t, 1, prompt.index)
+}
+
+func TestInput_format(t *testing.T) {
+	phone := terminal.Format("(999) 999-9999")
+	assert.Equal(t, "(555) 123-4567", string(phone.Apply([]rune("5551234567"))))
+	assert.Equal(t, "(555) 123-4567", string(phone.Apply([]rune("(555) 123-4567"))))
+	assert.True(t, phone.Complete([]rune("(555) 123-4567")))
+	assert.False(t, phone.Complete([]rune("(555) 12")))
+	assert.Equal(t, "5551234567", string(phone.Raw([]rune("(555) 123-4567"))))
+
+	// optional digits are skipped by typing the literal after them
+	ip := terminal.Format("###.###.###.###")
+	assert.Equal(t, "10.0.0.1", string(ip.Apply([]rune("10.0.0.1"))))
+	assert.True(t, ip.Complete([]rune("10.0.0.1")))
+	assert.False(t, ip.Complete([]rune("10.0")))
+
+	code := terminal.Format("AAAA-9999")
+	assert.Equal(t, "ab", string(code.Apply([]rune("ab12"))))
+	assert.False(t, code.Complete([]rune("ab12")))
+	assert.True(t, code.Complete([]rune("abcd1234")))
+	assert.Equal(t, "abcd1234", string(code.Raw([]rune("abcd-1234"))))
+
+	escaped := terminal.Format(`\A-99`)
+	assert.Equal(t, "A-12", string(escaped.Apply([]rune("12"))))
+	assert.Equal(t, "12", string(escaped.Raw([]rune("A-12"))))
+}
+
+func TestInput_formatRefusesSettings(t *testing.T) {
+	prompt := &Input{
+		Message: "Phone number:",
+		Format:  "(999) 999-9999",
+		Suggest: func(toComplete string) []string { return nil },
+	}
+	_, err := prompt.Prompt()
+	assert.EqualError(t, err, "Suggest can't be used along with a Format")
+
+	prompt = &Input{Message: "Phone number:", Format: "(999) 999-9999", Default: "555-1234"}
+	_, err = prompt.Prompt()
+	assert.EqualError(t, err, `the Default "555-1234" doesn't fit (999) 999-9999`)
+}
+
+func TestCommonPrefix(t *testing.T) {
+	assert.Equal(t, "Ju", commonPrefix([]string{"June", "July"}))
+	assert.Equal(t, "", commonPrefix([]string{"June", "May"}))
+	assert.Equal(t, "May", commonPrefix([]string{"May"}))
+}
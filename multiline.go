@@ -0,0 +1,333 @@
+package survey
+
+import (
+	"strings"
+	"unicode"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Multiline is a text input spanning several lines that is edited right in the prompt
+instead of in an external editor. Enter starts a new line, the arrow keys move around
+the text and SubmitKey (ctrl+d by default, or terminal.KeyAltEnter) accepts it. Long
+lines are wrapped to the width of the terminal. Response type is a string.
+
+	description := ""
+	prompt := &survey.Multiline{
+		Message:  "Describe the change:",
+		MaxLines: 5,
+	}
+	survey.AskOne(prompt, &description, nil)
+*/
+type Multiline struct {
+	core.Renderer
+	Message       string
+	Default       string
+	Help          string
+	SubmitKey     rune
+	MaxLines      int
+	Template      string
+	TemplateFuncs map[string]interface{}
+	lines         [][]rune
+	line          int
+	col           int
+	width         int
+	showingHelp   bool
+}
+
+// data available to the templates when processing
+type MultilineTemplateData struct {
+	Multiline
+	Rows       []string
+	Submit     string
+	Accessible bool
+	Answer     string
+	ShowAnswer bool
+	ShowHelp   bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var MultilineQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}{{"\n"}}
+  {{- range .Rows}}{{color "cyan"}}{{.}}{{color "reset"}}{{"\n"}}{{end}}
+{{- else }}
+  {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
+  {{- if .Accessible}}{{color "cyan"}}[Enter an empty line to submit]{{color "reset"}}{{"\n"}}
+  {{- else}}{{color "cyan"}}[Enter for a new line, {{ .Submit }} to submit]{{color "reset"}}{{"\n"}}
+  {{- range .Rows}}{{.}}{{"\n"}}{{end}}
+  {{- end}}
+{{- end}}`
+
+// submitKey returns the key that accepts the text
+func (m *Multiline) submitKey() rune {
+	if m.SubmitKey == 0 {
+		return terminal.KeyEndTransmission
+	}
+	return m.SubmitKey
+}
+
+// keyName returns how a key is shown in the instructions
+func keyName(key rune) string {
+	switch {
+	case key == terminal.KeyAltEnter:
+		return "Alt+Enter"
+	case key > 0 && key < terminal.KeySpace && key != terminal.KeyEscape:
+		return "Ctrl+" + string('A'+key-1)
+	}
+	return string(key)
+}
+
+// text returns the lines joined together
+func (m *Multiline) text() string {
+	lines := make([]string, len(m.lines))
+	for i, line := range m.lines {
+		lines[i] = string(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// setText replaces the text and puts the cursor at its end
+func (m *Multiline) setText(text string) {
+	m.lines = [][]rune{}
+	for _, line := range strings.Split(text, "\n") {
+		m.lines = append(m.lines, []rune(line))
+	}
+	m.line = len(m.lines) - 1
+	m.col = len(m.lines[m.line])
+}
+
+// OnChange is called on every keypress.
+func (m *Multiline) OnChange(key rune) {
+	current := m.lines[m.line]
+
+	switch {
+	case key == '\r' || key == '\n':
+		// there is no room for another line
+		if m.MaxLines > 0 && len(m.lines) >= m.MaxLines {
+			return
+		}
+		// split the line at the cursor
+		rest := append([]rune{}, current[m.col:]...)
+		m.lines[m.line] = current[:m.col]
+		m.lines = append(m.lines[:m.line+1], append([][]rune{rest}, m.lines[m.line+1:]...)...)
+		m.line++
+		m.col = 0
+	case key == terminal.KeyArrowLeft:
+		if m.col > 0 {
+			m.col--
+		} else if m.line > 0 {
+			m.line--
+			m.col = len(m.lines[m.line])
+		}
+	case key == terminal.KeyArrowRight:
+		if m.col < len(current) {
+			m.col++
+		} else if m.line < len(m.lines)-1 {
+			m.line++
+			m.col = 0
+		}
+	case key == terminal.KeyArrowUp:
+		if m.line > 0 {
+			m.line--
+			m.col = min(m.col, len(m.lines[m.line]))
+		}
+	case key == terminal.KeyArrowDown:
+		if m.line < len(m.lines)-1 {
+			m.line++
+			m.col = min(m.col, len(m.lines[m.line]))
+		}
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if m.col > 0 {
+			m.lines[m.line] = append(current[:m.col-1], current[m.col:]...)
+			m.col--
+		} else if m.line > 0 {
+			// join the line with the one above
+			m.line--
+			m.col = len(m.lines[m.line])
+			m.lines[m.line] = append(m.lines[m.line], current...)
+			m.lines = append(m.lines[:m.line+1], m.lines[m.line+2:]...)
+		}
+	case key == terminal.KeyDeleteWord:
+		// remove the word before the cursor along with the spaces after it
+		start := m.col
+		for start > 0 && unicode.IsSpace(current[start-1]) {
+			start--
+		}
+		for start > 0 && !unicode.IsSpace(current[start-1]) {
+			start--
+		}
+		m.lines[m.line] = append(current[:start], current[m.col:]...)
+		m.col = start
+	case key == terminal.KeyDeleteLine:
+		m.lines[m.line] = []rune{}
+		m.col = 0
+	case key == m.Config().HelpInputRune && m.Help != "" && m.text() == "":
+		m.showingHelp = true
+	case terminal.IsPrintable(key):
+		m.lines[m.line] = append(current[:m.col], append([]rune{key}, current[m.col:]...)...)
+		m.col++
+	}
+}
+
+// rows wraps the text to the width of the terminal, returning the rows to draw along
+// with the row and column of the cursor in them
+func (m *Multiline) rows() (rows []string, cursorRow, cursorCol int) {
+	// leave the last column free so the terminal doesn't wrap on its own
+	width := m.width - 1
+
+	for i, line := range m.lines {
+		if width <= 0 {
+			if i == m.line {
+				cursorRow, cursorCol = len(rows), m.col
+			}
+			rows = append(rows, string(line))
+			continue
+		}
+
+		if i == m.line {
+			cursorRow, cursorCol = len(rows)+m.col/width, m.col%width
+		}
+		// a full row is followed by an empty one for the cursor to go to
+		for start := 0; start <= len(line); start += width {
+			rows = append(rows, string(line[start:min(start+width, len(line))]))
+		}
+	}
+
+	return rows, cursorRow, cursorCol
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (m *Multiline) Prompt() (interface{}, error) {
+	m.setText(m.Default)
+	m.showingHelp = false
+	m.width = m.NewCursor().Width()
+
+	rr := m.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts don't move the cursor around the text so it is typed line by line
+	if m.Config().Accessible {
+		return m.accessiblePrompt(rr)
+	}
+
+	err := m.redraw()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			m.RestoreCursor()
+			return nil, terminal.InterruptErr
+		}
+		if r == m.submitKey() {
+			// leave the cursor at the bottom of the prompt for whatever comes next
+			m.RestoreCursor()
+			return m.text(), nil
+		}
+		m.OnChange(r)
+
+		err = m.redraw()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (m *Multiline) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	err := m.render(MultilineTemplateData{Multiline: *m, Accessible: true})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{}
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(line) == 0 {
+			break
+		}
+		if string(line) == string(m.Config().HelpInputRune) && m.Help != "" && len(lines) == 0 {
+			err = m.render(MultilineTemplateData{Multiline: *m, Accessible: true, ShowHelp: true})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		lines = append(lines, string(line))
+		if m.MaxLines > 0 && len(lines) >= m.MaxLines {
+			break
+		}
+	}
+
+	// nothing typed keeps the default
+	if len(lines) == 0 {
+		return m.Default, nil
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// redraw renders the text and moves the cursor to where the user is typing
+func (m *Multiline) redraw() error {
+	rows, row, col := m.rows()
+
+	// the rows are the last lines of the prompt, followed by a newline
+	return m.RenderWithCursor(
+		m.template(),
+		m.TemplateFuncs,
+		MultilineTemplateData{
+			Multiline: *m,
+			Rows:      rows,
+			Submit:    keyName(m.submitKey()),
+			ShowHelp:  m.showingHelp,
+		},
+		len(rows)-row,
+		len([]rune(rows[row]))-col,
+	)
+}
+
+func (m *Multiline) Cleanup(val interface{}) error {
+	m.setText(val.(string))
+	rows, _, _ := m.rows()
+
+	return m.render(MultilineTemplateData{
+		Multiline:  *m,
+		Rows:       rows,
+		Answer:     val.(string),
+		ShowAnswer: true,
+	})
+}
+
+// template returns the Template of the prompt, or MultilineQuestionTemplate if it has none
+func (m *Multiline) template() string {
+	if m.Template != "" {
+		return m.Template
+	}
+	return MultilineQuestionTemplate
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of MultilineQuestionTemplate.
+func (m *Multiline) render(data MultilineTemplateData) error {
+	return m.RenderWithFuncs(m.template(), m.TemplateFuncs, data)
+}
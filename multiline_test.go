@@ -0,0 +1,138 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+func TestMultilineRender(t *testing.T) {
+
+	tests := []struct {
+		title    string
+		prompt   Multiline
+		data     MultilineTemplateData
+		expected string
+	}{
+		{
+			"Test Multiline question output",
+			Multiline{Message: "Describe it:"},
+			MultilineTemplateData{Rows: []string{"first", "second"}, Submit: "Ctrl+D"},
+			"? Describe it: [Enter for a new line, Ctrl+D to submit]\nfirst\nsecond\n",
+		},
+		{
+			"Test Multiline question output with help hidden",
+			Multiline{Message: "Describe it:", Help: "This is helpful"},
+			MultilineTemplateData{Rows: []string{""}, Submit: "Alt+Enter"},
+			"? Describe it: [? for help] [Enter for a new line, Alt+Enter to submit]\n\n",
+		},
+		{
+			"Test Multiline accessible question output",
+			Multiline{Message: "Describe it:"},
+			MultilineTemplateData{Accessible: true},
+			"? Describe it: [Enter an empty line to submit]\n",
+		},
+		{
+			"Test Multiline answer output",
+			Multiline{Message: "Describe it:"},
+			MultilineTemplateData{Rows: []string{"first", "second"}, ShowAnswer: true},
+			"? Describe it: \nfirst\nsecond\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.data.Multiline = test.prompt
+		err := test.prompt.Render(
+			MultilineQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestMultiline_editing(t *testing.T) {
+	prompt := &Multiline{}
+	prompt.setText("")
+
+	for _, key := range "ab\rcd" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, "ab\ncd", prompt.text())
+
+	// the cursor keeps its column when moving up as far as the line allows
+	prompt.OnChange(terminal.KeyArrowLeft)
+	prompt.OnChange(terminal.KeyArrowUp)
+	prompt.OnChange('x')
+	assert.Equal(t, "axb\ncd", prompt.text())
+
+	// enter splits the line at the cursor
+	prompt.OnChange('\r')
+	assert.Equal(t, "ax\nb\ncd", prompt.text())
+
+	// backspace at the beginning of a line joins it with the one above
+	prompt.OnChange(terminal.KeyBackspace)
+	assert.Equal(t, "axb\ncd", prompt.text())
+
+	// the right arrow moves on to the next line
+	prompt.OnChange(terminal.KeyArrowRight)
+	prompt.OnChange(terminal.KeyArrowRight)
+	prompt.OnChange('y')
+	assert.Equal(t, "axb\nycd", prompt.text())
+
+	prompt.OnChange(terminal.KeyDeleteLine)
+	assert.Equal(t, "axb\n", prompt.text())
+}
+
+func TestMultiline_maxLines(t *testing.T) {
+	prompt := &Multiline{MaxLines: 2}
+	prompt.setText("")
+
+	for _, key := range "a\rb\rc" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, "a\nbc", prompt.text())
+}
+
+func TestMultiline_softWrap(t *testing.T) {
+	prompt := &Multiline{width: 5}
+	prompt.setText("abcdefghij\nkl")
+
+	// rows are one less than the width so the terminal doesn't wrap them itself
+	rows, row, col := prompt.rows()
+	assert.Equal(t, []string{"abcd", "efgh", "ij", "kl"}, rows)
+	assert.Equal(t, 3, row)
+	assert.Equal(t, 2, col)
+
+	// a full row gets an empty one after it for the cursor
+	prompt.setText("abcd")
+	rows, row, col = prompt.rows()
+	assert.Equal(t, []string{"abcd", ""}, rows)
+	assert.Equal(t, 1, row)
+	assert.Equal(t, 0, col)
+
+	// without a terminal nothing is wrapped
+	prompt = &Multiline{}
+	prompt.setText("abcdefghij")
+	rows, _, _ = prompt.rows()
+	assert.Equal(t, []string{"abcdefghij"}, rows)
+}
+
+func TestMultiline_keyName(t *testing.T) {
+	assert.Equal(t, "Ctrl+D", keyName(terminal.KeyEndTransmission))
+	assert.Equal(t, "Alt+Enter", keyName(terminal.KeyAltEnter))
+	// ctrl+] is a key of its own
+	assert.Equal(t, "Ctrl+]", keyName('\x1d'))
+}
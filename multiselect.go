@@ -2,7 +2,7 @@ package survey
 
 import (
 	"errors"
-	"os"
+	"fmt"
 	"strings"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
@@ -19,20 +19,47 @@ for them to select using the arrow keys and enter. Response type is a slice of s
 		Options: []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
 	}
 	survey.AskOne(prompt, &days, nil)
+
+Like with Select, options can also be listed in Groups, each under a header that can't
+be picked. An option listed in several groups is checked in all of them at once.
+
+Min and Max limit how many options can be checked while the prompt is shown. The right
+arrow checks every option matching the filter, the left arrow unchecks them and tab inverts
+them, as the hint above the options says.
+
+Other adds an entry after the options for the user to type answers that aren't among them,
+which are checked and listed with the options once typed.
 */
 type MultiSelect struct {
 	core.Renderer
-	Message       string
-	Options       []string
+	Message string
+	Options []string
+	// Groups are listed after the Options, each under its header
+	Groups        []OptionGroup
 	Default       []string
 	Help          string
 	PageSize      int
 	VimMode       bool
 	FilterMessage string
+	// Filter matches the options to what the user typed, FuzzyFilter by default
+	Filter func(filter string, option string) *FilterMatch
+	// Min and Max are the number of options that must be checked at least and can be
+	// checked at most, zero for no limit
+	Min int
+	Max int
+	// Other is the label of an entry listed after the options to type an answer that isn't
+	// one of them, none by default
+	Other         string
+	Template      string
+	TemplateFuncs map[string]interface{}
 	filter        string
 	selectedIndex int
 	checked       map[string]bool
+	hint          string
 	showingHelp   bool
+	other         otherAnswer
+	// others are the answers typed for the Other entry
+	others []string
 }
 
 // data available to the templates when processing
@@ -44,6 +71,20 @@ type MultiSelectTemplateData struct {
 	SelectedIndex int
 	ShowHelp      bool
 	PageEntries   []string
+	// PageHeaders holds the headers to show above the entries of the page, by the index of
+	// the entry they go above
+	PageHeaders map[int]string
+	// PageMatches splits the entries of the page matching the filter into the parts that
+	// matched and the ones that didn't, by their index
+	PageMatches map[int][]OptionSegment
+	// SelectedCount is the number of options checked
+	SelectedCount int
+	// Hint explains why the last key was refused
+	Hint string
+	// Typing is set while the user types an answer for the Other entry, which is
+	// OtherAnswer so far
+	Typing      bool
+	OtherAnswer string
 }
 
 var MultiSelectQuestionTemplate = `
@@ -52,33 +93,78 @@ var MultiSelectQuestionTemplate = `
 {{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
-	{{- "  "}}{{- color "cyan"}}[Use arrows to move, type to filter{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}
+	{{- "  "}}{{- color "cyan"}}
+  {{- if .Typing}}[Type your answer, enter to add it, esc to go back]
+  {{- else}}[Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter
+    {{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{end}}
+  {{- color "reset"}}
+  {{- if or .SelectedCount .Min .Max}}{{color "green"}} {{.SelectedCount}} selected
+    {{- if and .Min .Max}} ({{.Min}} to {{.Max}}){{else if .Min}} (at least {{.Min}}){{else if .Max}} (at most {{.Max}}){{end}}
+  {{- color "reset"}}{{end}}
   {{- "\n"}}
   {{- range $ix, $option := .PageEntries}}
+    {{- with index $.PageHeaders $ix}}{{color "default+u"}}{{.}}{{color "reset"}}{{"\n"}}{{end}}
     {{- if eq $ix $.SelectedIndex}}{{color "cyan"}}{{ SelectFocusIcon }}{{color "reset"}}{{else}} {{end}}
-    {{- if index $.Checked $option}}{{color "green"}} {{ MarkedOptionIcon }} {{else}}{{color "default+hb"}} {{ UnmarkedOptionIcon }} {{end}}
+    {{- if and $.Other (eq $option $.Other)}}{{"   "}}
+    {{- else if index $.Checked $option}}{{color "green"}} {{ MarkedOptionIcon }} {{else}}{{color "default+hb"}} {{ UnmarkedOptionIcon }} {{end}}
     {{- color "reset"}}
-    {{- " "}}{{$option}}{{"\n"}}
+    {{- " "}}
+    {{- with index $.PageMatches $ix}}
+      {{- range .}}{{if .Matched}}{{color "yellow+bu"}}{{.Text}}{{color "reset"}}{{else}}{{.Text}}{{end}}{{end}}
+    {{- else}}{{$option}}{{end}}
+    {{- if and $.Typing (eq $ix $.SelectedIndex)}} {{color "cyan"}}{{$.OtherAnswer}}{{color "reset"}}{{end}}{{"\n"}}
   {{- end}}
+  {{- if .Hint}}{{color "yellow"}}{{.Hint}}{{color "reset"}}{{"\n"}}{{end}}
 {{- end}}`
 
+// MultiSelectAccessibleQuestionTemplate is used instead of MultiSelectQuestionTemplate
+// when core.Accessible is set. The options are only printed the first time the question
+// is asked and the user picks them by typing their numbers or names.
+var MultiSelectAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .PageEntries}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+  {{- range $ix, $option := .PageEntries}}
+    {{- with index $.PageHeaders $ix}}{{.}}{{"\n"}}{{end}}
+    {{- "  "}}{{ inc $ix }}. {{$option}}{{"\n"}}
+  {{- end}}
+{{- end}}
+{{- color "cyan"}}Type numbers or names separated by commas
+{{- if and .Min .Max}}, {{.Min}} to {{.Max}}{{else if .Min}}, at least {{.Min}}{{else if .Max}}, at most {{.Max}}{{end}}
+{{- if .Default}} (default: {{range $ix, $option := .Default}}{{if $ix}}, {{end}}{{$option}}{{end}}){{end}}
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
 // OnChange is called on every keypress.
 func (m *MultiSelect) OnChange(line []rune, pos int, key rune) (newLine []rune, newPos int, ok bool) {
-	options := m.filterOptions()
+	options, _, _ := m.filterOptions()
+	entries, _, _ := m.entries()
 	oldFilter := m.filter
+	m.hint = ""
+
+	// the keys go to the answer of the Other entry while it is typed
+	if m.other.typing {
+		if key == terminal.KeyEnter {
+			m.addOther()
+		} else {
+			m.other.edit(key)
+		}
+		m.render(m.questionData())
+		return line, 0, true
+	}
 
 	if key == terminal.KeyArrowUp || (m.VimMode && key == 'k') {
 		// if we are at the top of the list
 		if m.selectedIndex == 0 {
 			// go to the bottom
-			m.selectedIndex = len(options) - 1
+			m.selectedIndex = len(entries) - 1
 		} else {
 			// decrement the selected index
 			m.selectedIndex--
 		}
 	} else if key == terminal.KeyArrowDown || (m.VimMode && key == 'j') {
 		// if we are at the bottom of the list
-		if m.selectedIndex == len(options)-1 {
+		if m.selectedIndex == len(entries)-1 {
 			// start at the top
 			m.selectedIndex = 0
 		} else {
@@ -87,17 +173,51 @@ func (m *MultiSelect) OnChange(line []rune, pos int, key rune) (newLine []rune,
 		}
 		// if the user pressed down and there is room to move
 	} else if key == terminal.KeySpace {
-		if m.selectedIndex < len(options) {
-			if old, ok := m.checked[options[m.selectedIndex]]; !ok {
-				// otherwise just invert the current value
-				m.checked[options[m.selectedIndex]] = true
+		if m.otherSelected() {
+			m.other.start(m.filter)
+		} else if m.selectedIndex < len(options) {
+			option := options[m.selectedIndex]
+			if m.checked[option] {
+				m.checked[option] = false
+			} else if m.Max > 0 && m.countChecked() >= m.Max {
+				// there is no room for another option
+				m.hint = m.maxHint()
 			} else {
-				// otherwise just invert the current value
-				m.checked[options[m.selectedIndex]] = !old
+				m.checked[option] = true
 			}
 		}
+	} else if key == terminal.KeyArrowRight {
+		// check the options matching the filter, as many as there is room for
+		for _, option := range options {
+			if m.checked[option] {
+				continue
+			}
+			if m.Max > 0 && m.countChecked() >= m.Max {
+				m.hint = m.maxHint()
+				break
+			}
+			m.checked[option] = true
+		}
+	} else if key == terminal.KeyArrowLeft {
+		for _, option := range options {
+			m.checked[option] = false
+		}
+	} else if key == '\t' {
+		inverted := map[string]bool{}
+		for option, checked := range m.checked {
+			inverted[option] = checked
+		}
+		for _, option := range options {
+			inverted[option] = !m.checked[option]
+		}
+		// the selection is only inverted if it fits
+		if m.Max > 0 && countChecked(m.allOptions(), inverted) > m.Max {
+			m.hint = m.maxHint()
+		} else {
+			m.checked = inverted
+		}
 		// only show the help message if we have one to show
-	} else if key == core.HelpInputRune && m.Help != "" {
+	} else if key == m.Config().HelpInputRune && m.Help != "" {
 		m.showingHelp = true
 	} else if key == terminal.KeyEscape {
 		m.VimMode = !m.VimMode
@@ -107,7 +227,7 @@ func (m *MultiSelect) OnChange(line []rune, pos int, key rune) (newLine []rune,
 		if m.filter != "" {
 			m.filter = m.filter[0 : len(m.filter)-1]
 		}
-	} else if key >= terminal.KeySpace {
+	} else if terminal.IsPrintable(key) {
 		m.filter += string(key)
 		m.VimMode = false
 	}
@@ -118,54 +238,147 @@ func (m *MultiSelect) OnChange(line []rune, pos int, key rune) (newLine []rune,
 	}
 	if oldFilter != m.filter {
 		// filter changed
-		options = m.filterOptions()
-		if len(options) > 0 && len(options) <= m.selectedIndex {
-			m.selectedIndex = len(options) - 1
-		}
+		options, _, _ = m.filterOptions()
+		// the options were ranked again so start over from the best match
+		m.selectedIndex = 0
 	}
-	// paginate the options
-
-	// TODO if we have started filtering and were looking at the end of a list
-	// and we have modified the filter then we should move the page back!
-	opts, idx := paginate(m.PageSize, options, m.selectedIndex)
-
 	// render the options
-	m.Render(
-		MultiSelectQuestionTemplate,
-		MultiSelectTemplateData{
-			MultiSelect:   *m,
-			SelectedIndex: idx,
-			Checked:       m.checked,
-			ShowHelp:      m.showingHelp,
-			PageEntries:   opts,
-		},
-	)
+	m.render(m.questionData())
 
 	// if we are not pressing ent
 	return line, 0, true
 }
 
-func (m *MultiSelect) filterOptions() []string {
-	filter := strings.ToLower(m.filter)
-	if filter == "" {
-		return m.Options
+// filterOptions returns the options matching the filter, ranked by how well they match
+// within their group, along with the header of each of them, which leaves out the groups
+// without a match, and the positions of the characters that matched
+func (m *MultiSelect) filterOptions() ([]string, []string, [][]int) {
+	options, headers := m.groupedOptions()
+	return filterGroupedOptions(options, headers, m.filter, m.Filter)
+}
+
+// entries returns the filtered options followed by the Other entry if there is one
+func (m *MultiSelect) entries() ([]string, []string, [][]int) {
+	options, headers, positions := m.filterOptions()
+	return withOther(m.Other, options, headers, positions)
+}
+
+// otherSelected returns true if the Other entry is highlighted
+func (m *MultiSelect) otherSelected() bool {
+	entries, _, _ := m.entries()
+	return m.Other != "" && m.selectedIndex == len(entries)-1
+}
+
+// addOther checks the answer typed for the Other entry, which is listed with the options
+// from then on, unless there is no room for it
+func (m *MultiSelect) addOther() {
+	answer := m.other.answer()
+	if answer == "" {
+		return
+	}
+	if !m.checked[answer] && m.Max > 0 && m.countChecked() >= m.Max {
+		m.hint = m.maxHint()
+		return
+	}
+
+	if !contains(m.allOptions(), answer) {
+		m.others = append(m.others, answer)
+	}
+	m.checked[answer] = true
+	m.other = otherAnswer{}
+}
+
+// questionData returns the data to render the visible page of the options
+func (m *MultiSelect) questionData() MultiSelectTemplateData {
+	options, headers, positions := m.entries()
+
+	// TODO if we have started filtering and were looking at the end of a list
+	// and we have modified the filter then we should move the page back!
+	opts, idx := paginate(pageSize(m.PageSize, m.Config()), options, m.selectedIndex)
+
+	return MultiSelectTemplateData{
+		MultiSelect:   *m,
+		SelectedIndex: idx,
+		Checked:       m.checked,
+		ShowHelp:      m.showingHelp,
+		PageEntries:   opts,
+		PageHeaders:   pageHeaders(headers, m.selectedIndex-idx, len(opts)),
+		PageMatches:   pageSegments(opts, positions, m.selectedIndex-idx),
+		SelectedCount: m.countChecked(),
+		Hint:          m.hint,
+		Typing:        m.other.typing,
+		OtherAnswer:   string(m.other.text),
 	}
-	answer := []string{}
-	for _, o := range m.Options {
-		if strings.Contains(strings.ToLower(o), filter) {
-			answer = append(answer, o)
+}
+
+// allOptions returns the options followed by the options of every group and the answers
+// typed for the Other entry
+func (m *MultiSelect) allOptions() []string {
+	options, _ := m.groupedOptions()
+	return options
+}
+
+// groupedOptions returns the options followed by the options of every group and the
+// answers typed for the Other entry, along with the header of each of them
+func (m *MultiSelect) groupedOptions() ([]string, []string) {
+	options, headers := groupOptions(m.Options, m.Groups)
+	return append(options, m.others...), append(headers, make([]string, len(m.others))...)
+}
+
+// countChecked returns the number of options checked
+func (m *MultiSelect) countChecked() int {
+	return countChecked(m.allOptions(), m.checked)
+}
+
+// countChecked returns the number of different options that are checked
+func countChecked(options []string, checked map[string]bool) int {
+	counted := map[string]bool{}
+	for _, option := range options {
+		if checked[option] {
+			counted[option] = true
 		}
 	}
-	return answer
+	return len(counted)
+}
+
+// maxHint explains that no more options can be checked
+func (m *MultiSelect) maxHint() string {
+	return fmt.Sprintf("at most %v can be selected", plural(m.Max, "option"))
+}
+
+// limitHint explains why the checked options can't be the answer, if they can't
+func (m *MultiSelect) limitHint() string {
+	count := m.countChecked()
+	if m.Min > 0 && count < m.Min {
+		return fmt.Sprintf("at least %v must be selected", plural(m.Min, "option"))
+	}
+	if m.Max > 0 && count > m.Max {
+		return m.maxHint()
+	}
+	return ""
+}
+
+// plural returns the count followed by the noun, in plural unless the count is 1
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%v %v", count, noun)
+	}
+	return fmt.Sprintf("%v %vs", count, noun)
 }
 
 func (m *MultiSelect) Prompt() (interface{}, error) {
+	options, _ := groupOptions(m.Options, m.Groups)
+
+	m.hint = ""
+	m.other = otherAnswer{}
+	m.others = nil
+
 	// compute the default state
 	m.checked = make(map[string]bool)
 	// if there is a default
 	if len(m.Default) > 0 {
 		for _, dflt := range m.Default {
-			for _, opt := range m.Options {
+			for _, opt := range options {
 				// if the option correponds to the default
 				if opt == dflt {
 					// we found our initial value
@@ -178,70 +391,184 @@ func (m *MultiSelect) Prompt() (interface{}, error) {
 	}
 
 	// if there are no options to render
-	if len(m.Options) == 0 {
+	if len(options) == 0 {
 		// we failed
 		return "", errors.New("please provide options to select from")
 	}
 
-	// paginate the options
-	opts, idx := paginate(m.PageSize, m.Options, m.selectedIndex)
+	// accessible prompts are answered by typing instead of moving around the list
+	if m.Config().Accessible {
+		return m.accessiblePrompt()
+	}
 
 	// hide the cursor
-	terminal.CursorHide()
+	m.NewCursor().Hide()
 
 	// show the cursor when we're done
-	defer terminal.CursorShow()
+	defer m.NewCursor().Show()
 
 	// ask the question
-	err := m.Render(
-		MultiSelectQuestionTemplate,
-		MultiSelectTemplateData{
-			MultiSelect:   *m,
-			SelectedIndex: idx,
-			Checked:       m.checked,
-			PageEntries:   opts,
-		},
-	)
+	err := m.render(m.questionData())
 	if err != nil {
 		return "", err
 	}
 
-	rr := terminal.NewRuneReader(os.Stdin)
+	rr := m.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 
 	// start waiting for input
 	for {
 		r, _, _ := rr.ReadRune()
-		if r == '\r' || r == '\n' {
-			break
-		}
 		if r == terminal.KeyInterrupt {
 			return "", terminal.InterruptErr
 		}
-		if r == terminal.KeyEndTransmission {
-			break
+		// enter adds the answer typed for the Other entry
+		if m.other.typing && (r == '\r' || r == '\n') {
+			m.OnChange(nil, 0, terminal.KeyEnter)
+			continue
+		}
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			// the answer has to stay within the limits
+			m.hint = m.limitHint()
+			if m.hint == "" {
+				break
+			}
+			err = m.render(m.questionData())
+			if err != nil {
+				return "", err
+			}
+			continue
 		}
 		m.OnChange(nil, 0, r)
 	}
 	m.filter = ""
 	m.FilterMessage = ""
 
+	return m.checkedOptions(), nil
+}
+
+// checkedOptions returns the checked options in the order they were given, only once
+// when they are listed in several groups.
+func (m *MultiSelect) checkedOptions() []string {
+	options := m.allOptions()
 	answers := []string{}
-	for _, option := range m.Options {
-		if val, ok := m.checked[option]; ok && val {
+	for _, option := range options {
+		if val, ok := m.checked[option]; ok && val && !contains(answers, option) {
 			answers = append(answers, option)
 		}
 	}
+	return answers
+}
+
+// accessiblePrompt prints the numbered list of options once and waits for the user
+// to type the numbers or names of their choices, without ever redrawing the prompt.
+func (m *MultiSelect) accessiblePrompt() (interface{}, error) {
+	options, headers := groupOptions(m.Options, m.Groups)
+	options, headers, _ = withOther(m.Other, options, headers, nil)
+
+	// print the question along with every option
+	err := m.RenderWithFuncs(
+		MultiSelectAccessibleQuestionTemplate,
+		m.TemplateFuncs,
+		MultiSelectTemplateData{
+			MultiSelect: *m,
+			Checked:     m.checked,
+			PageEntries: options,
+			PageHeaders: pageHeaders(headers, 0, len(options)),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
 
-	return answers, nil
+	rr := m.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+		val := strings.TrimSpace(string(line))
+		showHelp := false
+
+		switch {
+		case val == string(m.Config().HelpInputRune) && m.Help != "":
+			showHelp = true
+		default:
+			err := m.accessibleAnswer(options, val)
+			// the Other entry asks for the answer to add
+			if err == nil && m.Other != "" && m.checked[m.Other] {
+				err = m.accessibleOther(rr)
+			}
+			if err == nil {
+				return m.checkedOptions(), nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := m.Error(err); err != nil {
+				return "", err
+			}
+		}
+
+		// ask again without repeating the list of options
+		err = m.RenderWithFuncs(
+			MultiSelectAccessibleQuestionTemplate,
+			m.TemplateFuncs,
+			MultiSelectTemplateData{MultiSelect: *m, Checked: m.checked, ShowHelp: showHelp},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// accessibleAnswer checks the options typed in accessible mode instead of the default
+// ones, which an empty response keeps, as long as they are within the limits.
+func (m *MultiSelect) accessibleAnswer(options []string, val string) error {
+	checked := m.checked
+	if val != "" {
+		choices, err := accessibleChoices(options, val)
+		if err != nil {
+			return err
+		}
+		checked = make(map[string]bool)
+		for _, choice := range choices {
+			checked[choice] = true
+		}
+	}
+
+	previous := m.checked
+	m.checked = checked
+	if hint := m.limitHint(); hint != "" {
+		m.checked = previous
+		return fmt.Errorf("%v, please try again.", hint)
+	}
+	return nil
+}
+
+// accessibleOther checks the answer typed in place of the Other entry
+func (m *MultiSelect) accessibleOther(rr *terminal.RuneReader) error {
+	delete(m.checked, m.Other)
+
+	answer, err := accessibleOther(&m.Renderer, m.TemplateFuncs, rr)
+	if err != nil {
+		return err
+	}
+	m.other.start(answer)
+	m.addOther()
+	if m.hint != "" {
+		m.other = otherAnswer{}
+		return fmt.Errorf("%v, please try again.", m.hint)
+	}
+	return nil
 }
 
 // Cleanup removes the options section, and renders the ask like a normal question.
 func (m *MultiSelect) Cleanup(val interface{}) error {
 	// execute the output summary template with the answer
-	return m.Render(
-		MultiSelectQuestionTemplate,
+	return m.render(
 		MultiSelectTemplateData{
 			MultiSelect:   *m,
 			SelectedIndex: m.selectedIndex,
@@ -251,3 +578,13 @@ func (m *MultiSelect) Cleanup(val interface{}) error {
 		},
 	)
 }
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of MultiSelectQuestionTemplate.
+func (m *MultiSelect) render(data MultiSelectTemplateData) error {
+	tmpl := MultiSelectQuestionTemplate
+	if m.Template != "" {
+		tmpl = m.Template
+	}
+	return m.RenderWithFuncs(tmpl, m.TemplateFuncs, data)
+}
@@ -39,7 +39,7 @@ func TestMultiSelectRender(t *testing.T) {
 				PageEntries:   prompt.Options,
 				Checked:       map[string]bool{"bar": true, "buz": true},
 			},
-			`? Pick your words:  [Use arrows to move, type to filter]
+			`? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter]
   ◯  foo
   ◉  bar
 ❯ ◯  baz
@@ -63,7 +63,7 @@ func TestMultiSelectRender(t *testing.T) {
 				PageEntries:   prompt.Options,
 				Checked:       map[string]bool{"bar": true, "buz": true},
 			},
-			`? Pick your words:  [Use arrows to move, type to filter, ? for more help]
+			`? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter, ? for more help]
   ◯  foo
   ◉  bar
 ❯ ◯  baz
@@ -80,11 +80,57 @@ func TestMultiSelectRender(t *testing.T) {
 				ShowHelp:      true,
 			},
 			`ⓘ This is helpful
-? Pick your words:  [Use arrows to move, type to filter]
+? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter]
   ◯  foo
   ◉  bar
 ❯ ◯  baz
   ◉  buz
+`,
+		},
+		{
+			"Test MultiSelect question output with matches",
+			prompt,
+			MultiSelectTemplateData{
+				SelectedIndex: 0,
+				PageEntries:   []string{"baz"},
+				PageMatches:   map[int][]OptionSegment{0: {{Text: "ba", Matched: true}, {Text: "z"}}},
+			},
+			`? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter]
+❯ ◯  baz
+`,
+		},
+		{
+			"Test MultiSelect question output with a counter",
+			prompt,
+			MultiSelectTemplateData{
+				SelectedIndex: 2,
+				PageEntries:   prompt.Options,
+				Checked:       map[string]bool{"bar": true, "buz": true},
+				SelectedCount: 2,
+			},
+			`? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter] 2 selected
+  ◯  foo
+  ◉  bar
+❯ ◯  baz
+  ◉  buz
+`,
+		},
+		{
+			"Test MultiSelect question output with limits and a hint",
+			MultiSelect{Message: "Pick your words:", Min: 1, Max: 2},
+			MultiSelectTemplateData{
+				SelectedIndex: 2,
+				PageEntries:   prompt.Options,
+				Checked:       map[string]bool{"bar": true, "buz": true},
+				SelectedCount: 2,
+				Hint:          "at most 2 options can be selected",
+			},
+			`? Pick your words:  [Use arrows to move, <right> to all, <left> to none, tab to invert, type to filter] 2 selected (1 to 2)
+  ◯  foo
+  ◉  bar
+❯ ◯  baz
+  ◉  buz
+at most 2 options can be selected
 `,
 		},
 	}
@@ -103,3 +149,136 @@ func TestMultiSelectRender(t *testing.T) {
 		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
 	}
 }
+
+func TestMultiSelectAccessibleRender(t *testing.T) {
+
+	prompt := MultiSelect{
+		Message: "Pick your words:",
+		Options: []string{"foo", "bar", "baz", "buz"},
+		Default: []string{"bar", "buz"},
+	}
+
+	tests := []struct {
+		title    string
+		data     MultiSelectTemplateData
+		expected string
+	}{
+		{
+			"Test accessible MultiSelect question output",
+			MultiSelectTemplateData{PageEntries: prompt.Options},
+			`? Pick your words:
+  1. foo
+  2. bar
+  3. baz
+  4. buz
+Type numbers or names separated by commas (default: bar, buz): `,
+		},
+		{
+			"Test accessible MultiSelect question output when asking again",
+			MultiSelectTemplateData{},
+			`Type numbers or names separated by commas (default: bar, buz): `,
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.data.MultiSelect = prompt
+		err := prompt.Render(
+			MultiSelectAccessibleQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestMultiSelect_groups(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &MultiSelect{
+		Message: "Pick projects:",
+		Groups: []OptionGroup{
+			{Header: "Recently used", Options: []string{"web"}},
+			{Header: "All projects", Options: []string{"api", "web"}},
+		},
+		checked: map[string]bool{},
+	}
+
+	data := prompt.questionData()
+	assert.Equal(t, []string{"web", "api", "web"}, data.PageEntries)
+	assert.Equal(t, map[int]string{0: "Recently used", 1: "All projects"}, data.PageHeaders)
+
+	// an option listed in several groups is only answered once
+	prompt.OnChange(nil, 0, terminal.KeySpace)
+	assert.Equal(t, []string{"web"}, prompt.checkedOptions())
+}
+
+func TestMultiSelect_limits(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &MultiSelect{
+		Message: "Pick your words:",
+		Options: []string{"foo", "bar", "baz", "buz"},
+		Min:     2,
+		Max:     3,
+		checked: map[string]bool{},
+	}
+
+	prompt.OnChange(nil, 0, terminal.KeySpace)
+	assert.Equal(t, "at least 2 options must be selected", prompt.limitHint())
+
+	// checking every option stops at the limit
+	prompt.OnChange(nil, 0, terminal.KeyArrowRight)
+	assert.Equal(t, []string{"foo", "bar", "baz"}, prompt.checkedOptions())
+	assert.Equal(t, "at most 3 options can be selected", prompt.hint)
+	assert.Equal(t, "", prompt.limitHint())
+
+	// space is refused once the limit is reached
+	prompt.selectedIndex = 3
+	prompt.OnChange(nil, 0, terminal.KeySpace)
+	assert.Equal(t, []string{"foo", "bar", "baz"}, prompt.checkedOptions())
+	assert.Equal(t, "at most 3 options can be selected", prompt.questionData().Hint)
+	assert.Equal(t, 3, prompt.questionData().SelectedCount)
+
+	// the hint goes away with the next key
+	prompt.OnChange(nil, 0, terminal.KeyArrowUp)
+	assert.Equal(t, "", prompt.hint)
+
+	// the keys only apply to the options matching the filter
+	prompt.OnChange(nil, 0, 'b')
+	prompt.OnChange(nil, 0, '\t')
+	assert.Equal(t, []string{"foo", "buz"}, prompt.checkedOptions())
+	prompt.OnChange(nil, 0, terminal.KeyArrowLeft)
+	assert.Equal(t, []string{"foo"}, prompt.checkedOptions())
+}
+
+func TestMultiSelect_accessibleLimits(t *testing.T) {
+	prompt := &MultiSelect{
+		Options: []string{"foo", "bar", "baz"},
+		Max:     1,
+		checked: map[string]bool{"foo": true},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	err := prompt.Render(MultiSelectAccessibleQuestionTemplate, MultiSelectTemplateData{MultiSelect: *prompt})
+	assert.Nil(t, err)
+	assert.Equal(t, "Type numbers or names separated by commas, at most 1: ", outputBuffer.String())
+
+	err = prompt.accessibleAnswer(prompt.Options, "1, 2")
+	assert.EqualError(t, err, "at most 1 option can be selected, please try again.")
+	assert.Equal(t, []string{"foo"}, prompt.checkedOptions())
+
+	assert.Nil(t, prompt.accessibleAnswer(prompt.Options, "baz"))
+	assert.Equal(t, []string{"baz"}, prompt.checkedOptions())
+
+	// an empty response keeps the options checked
+	assert.Nil(t, prompt.accessibleAnswer(prompt.Options, ""))
+	assert.Equal(t, []string{"baz"}, prompt.checkedOptions())
+}
@@ -0,0 +1,303 @@
+package survey
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Number is a prompt for numeric answers. Only numeric keystrokes are accepted and the
+value can be adjusted by Step with the up and down arrows. When Min and Max are both
+zero the value is unbounded, and a Max left at zero under a positive Min leaves the values
+above Min open. Use math.Inf to leave the other side open. Response type is an int, or a
+float64 when Float is set.
+
+	age := 0
+	prompt := &survey.Number{
+		Message: "How old are you?",
+		Min:     0,
+		Max:     150,
+	}
+	survey.AskOne(prompt, &age, nil)
+*/
+type Number struct {
+	core.Renderer
+	Message       string
+	Default       float64
+	Min           float64
+	Max           float64
+	Step          float64
+	Float         bool
+	Help          string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	value         string
+	showingHelp   bool
+}
+
+// data available to the templates when processing
+type NumberTemplateData struct {
+	Number
+	Value      string
+	Range      string
+	Answer     string
+	ShowAnswer bool
+	ShowHelp   bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var NumberQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
+  {{- if .Range}}{{color "cyan"}}[{{.Range}}]{{color "reset"}} {{end}}
+  {{- if .Default}}{{color "white"}}({{.Default}}) {{color "reset"}}{{end}}
+  {{- .Value}}
+{{- end}}`
+
+// OnChange is called on every keypress.
+func (n *Number) OnChange(key rune) {
+	switch {
+	case key == terminal.KeyArrowUp:
+		n.step(1)
+	case key == terminal.KeyArrowDown:
+		n.step(-1)
+	case key == n.Config().HelpInputRune && n.Help != "":
+		n.showingHelp = true
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if n.value != "" {
+			n.value = n.value[:len(n.value)-1]
+		}
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		n.value = ""
+	case key >= '0' && key <= '9':
+		n.value += string(key)
+	// a sign is only valid at the beginning of the number
+	case key == '-' && n.value == "":
+		n.value += string(key)
+	// there is only one decimal separator in a float
+	case key == '.' && n.Float && !strings.Contains(n.value, "."):
+		n.value += string(key)
+	}
+}
+
+// step moves the current value by the given number of steps, staying in the range
+func (n *Number) step(steps float64) {
+	step := n.Step
+	if step == 0 {
+		step = 1
+	}
+
+	// start from the default when nothing was typed yet
+	current := n.Default
+	if val, err := strconv.ParseFloat(n.value, 64); err == nil {
+		current = val
+	}
+
+	n.value = n.format(n.clamp(current + steps*step))
+}
+
+// bounds returns the lowest and the highest values allowed
+func (n *Number) bounds() (float64, float64) {
+	switch {
+	case n.Min == 0 && n.Max == 0:
+		return math.Inf(-1), math.Inf(1)
+	case n.Max == 0 && n.Min > 0:
+		// only Min was set
+		return n.Min, math.Inf(1)
+	}
+	return n.Min, n.Max
+}
+
+func (n *Number) clamp(val float64) float64 {
+	min, max := n.bounds()
+	return math.Max(min, math.Min(max, val))
+}
+
+// format prints the value with as many decimals as the settings of the prompt need so
+// adding up steps does not show floating point noise
+func (n *Number) format(val float64) string {
+	if !n.Float {
+		return strconv.FormatInt(int64(val), 10)
+	}
+
+	decimals := 0
+	for _, num := range []float64{n.Step, n.Default, n.Min, n.Max} {
+		if math.IsInf(num, 0) {
+			continue
+		}
+		str := strconv.FormatFloat(num, 'f', -1, 64)
+		if i := strings.Index(str, "."); i >= 0 && len(str)-i-1 > decimals {
+			decimals = len(str) - i - 1
+		}
+	}
+	return strconv.FormatFloat(val, 'f', decimals, 64)
+}
+
+// describeRange returns a description of the allowed values for the template
+func (n *Number) describeRange() string {
+	min, max := n.bounds()
+	switch {
+	case math.IsInf(min, -1) && math.IsInf(max, 1):
+		return ""
+	case math.IsInf(min, -1):
+		return "at most " + n.format(max)
+	case math.IsInf(max, 1):
+		return "at least " + n.format(min)
+	}
+	return n.format(min) + " to " + n.format(max)
+}
+
+// parse returns the typed value in the type of the answer, or the default if nothing
+// was typed
+func (n *Number) parse(value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		value = n.format(n.Default)
+	}
+
+	min, max := n.bounds()
+	invalid := fmt.Errorf("%q is not a valid number, please try again.", value)
+	outOfRange := fmt.Errorf("%v is not %v, please try again.", value, n.describeRange())
+
+	if n.Float {
+		val, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		if val < min || val > max {
+			return nil, outOfRange
+		}
+		return val, nil
+	}
+
+	val, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, invalid
+	}
+	if float64(val) < min || float64(val) > max {
+		return nil, outOfRange
+	}
+	return val, nil
+}
+
+func (n *Number) Prompt() (interface{}, error) {
+	// no value could ever be accepted
+	if min, max := n.bounds(); min > max {
+		return nil, fmt.Errorf("the Min of %v is above the Max of %v", n.format(n.Min), n.format(n.Max))
+	}
+
+	n.value = ""
+	n.showingHelp = false
+
+	// render the template
+	err := n.render(n.questionData())
+	if err != nil {
+		return nil, err
+	}
+
+	rr := n.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts don't redraw on every key so the value is typed as a line
+	if n.Config().Accessible {
+		return n.accessiblePrompt(rr)
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if r == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			val, err := n.parse(n.value)
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := n.Error(err); err != nil {
+				return nil, err
+			}
+		} else {
+			n.OnChange(r)
+		}
+
+		err = n.render(n.questionData())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (n *Number) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if string(line) == string(n.Config().HelpInputRune) && n.Help != "" {
+			n.showingHelp = true
+		} else {
+			val, err := n.parse(string(line))
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := n.Error(err); err != nil {
+				return nil, err
+			}
+		}
+
+		err = n.render(n.questionData())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (n *Number) Cleanup(val interface{}) error {
+	answer := fmt.Sprint(val)
+	if f, ok := val.(float64); ok {
+		answer = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	return n.render(NumberTemplateData{
+		Number:     *n,
+		Answer:     answer,
+		ShowAnswer: true,
+	})
+}
+
+// questionData returns the data to render the question with the current value
+func (n *Number) questionData() NumberTemplateData {
+	return NumberTemplateData{
+		Number:   *n,
+		Value:    n.value,
+		Range:    n.describeRange(),
+		ShowHelp: n.showingHelp,
+	}
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of NumberQuestionTemplate.
+func (n *Number) render(data NumberTemplateData) error {
+	tmpl := NumberQuestionTemplate
+	if n.Template != "" {
+		tmpl = n.Template
+	}
+	return n.RenderWithFuncs(tmpl, n.TemplateFuncs, data)
+}
@@ -0,0 +1,144 @@
+package survey
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+func TestNumberRender(t *testing.T) {
+
+	tests := []struct {
+		title    string
+		prompt   Number
+		data     NumberTemplateData
+		expected string
+	}{
+		{
+			"Test Number question output without range",
+			Number{Message: "How many?"},
+			NumberTemplateData{Value: "12"},
+			"? How many? 12",
+		},
+		{
+			"Test Number question output with range and default",
+			Number{Message: "How many?", Min: 1, Max: 10, Default: 5},
+			NumberTemplateData{Range: "1 to 10"},
+			"? How many? [1 to 10] (5) ",
+		},
+		{
+			"Test Number answer output",
+			Number{Message: "How many?"},
+			NumberTemplateData{Answer: "3", ShowAnswer: true},
+			"? How many? 3\n",
+		},
+		{
+			"Test Number question output with help hidden",
+			Number{Message: "How many?", Help: "This is helpful"},
+			NumberTemplateData{},
+			"? How many? [? for help] ",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.data.Number = test.prompt
+		err := test.prompt.Render(
+			NumberQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestNumber_onlyAcceptsNumericKeys(t *testing.T) {
+	prompt := &Number{Float: true}
+
+	for _, key := range "-1a.2.5-x" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, "-1.25", prompt.value)
+
+	prompt = &Number{}
+	for _, key := range "1.5" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, "15", prompt.value)
+}
+
+func TestNumber_arrowsAdjustTheValue(t *testing.T) {
+	prompt := &Number{Default: 4, Min: 1, Max: 5}
+
+	// starts from the default
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, "5", prompt.value)
+
+	// stays in range
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, "5", prompt.value)
+
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, "4", prompt.value)
+
+	// steps don't accumulate floating point noise
+	prompt = &Number{Float: true, Step: 0.1}
+	for i := 0; i < 3; i++ {
+		prompt.OnChange(terminal.KeyArrowUp)
+	}
+	assert.Equal(t, "0.3", prompt.value)
+}
+
+func TestNumber_parse(t *testing.T) {
+	tests := []struct {
+		title    string
+		prompt   Number
+		value    string
+		expected interface{}
+		valid    bool
+	}{
+		{"parses ints", Number{}, "42", 42, true},
+		{"parses floats", Number{Float: true}, "4.5", 4.5, true},
+		{"uses the default", Number{Default: 3}, "", 3, true},
+		{"rejects values below the range", Number{Min: 1, Max: 10}, "0", nil, false},
+		{"rejects values above the range", Number{Min: 1, Max: 10}, "11", nil, false},
+		{"allows open ranges", Number{Min: 1, Max: math.Inf(1)}, "1000", 1000, true},
+		{"leaves the top open with only a Min", Number{Min: 1}, "1000", 1000, true},
+		{"keeps a range ending at zero", Number{Min: -5}, "1", nil, false},
+		{"rejects floats for ints", Number{}, "1.5", nil, false},
+		{"rejects a lonely sign", Number{}, "-", nil, false},
+	}
+
+	for _, test := range tests {
+		val, err := test.prompt.parse(test.value)
+		assert.Equal(t, test.valid, err == nil, test.title)
+		assert.Equal(t, test.expected, val, test.title)
+	}
+}
+
+func TestNumber_describeRange(t *testing.T) {
+	assert.Equal(t, "", (&Number{}).describeRange())
+	assert.Equal(t, "1 to 10", (&Number{Min: 1, Max: 10}).describeRange())
+	assert.Equal(t, "at least 1", (&Number{Min: 1, Max: math.Inf(1)}).describeRange())
+	assert.Equal(t, "at most 0.5", (&Number{Float: true, Min: math.Inf(-1), Max: 0.5}).describeRange())
+	assert.Equal(t, "at least 1", (&Number{Min: 1}).describeRange())
+	assert.Equal(t, "-5 to 0", (&Number{Min: -5}).describeRange())
+}
+
+func TestNumber_minAboveMax(t *testing.T) {
+	prompt := &Number{Min: 10, Max: 5}
+	_, err := prompt.Prompt()
+	assert.EqualError(t, err, "the Min of 10 is above the Max of 5")
+}
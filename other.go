@@ -0,0 +1,76 @@
+package survey
+
+import (
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+// OtherAccessibleTemplate asks for the answer to type in place of the options once the
+// Other entry of a Select or MultiSelect was picked in accessible mode.
+var OtherAccessibleTemplate = `{{color "cyan"}}Type your answer:{{color "reset"}} `
+
+// otherAnswer is the answer typed in place of the options after picking the Other entry
+// of a Select or MultiSelect
+type otherAnswer struct {
+	typing bool
+	text   []rune
+}
+
+// start switches to typing the answer, starting from what was typed to filter the
+// options since it likely is what the user was looking for
+func (o *otherAnswer) start(filter string) {
+	o.typing = true
+	o.text = []rune(filter)
+}
+
+// edit changes the answer with the key pressed. Escape goes back to the options.
+func (o *otherAnswer) edit(key rune) {
+	switch {
+	case key == terminal.KeyEscape:
+		o.typing = false
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		o.text = []rune{}
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if len(o.text) > 0 {
+			o.text = o.text[:len(o.text)-1]
+		}
+	case terminal.IsPrintable(key):
+		o.text = append(o.text, key)
+	}
+}
+
+// answer returns what was typed without the spaces around it
+func (o *otherAnswer) answer() string {
+	return strings.TrimSpace(string(o.text))
+}
+
+// withOther adds the Other entry after the options if there is one, with no header and no
+// characters matching the filter
+func withOther(other string, options []string, headers []string, positions [][]int) ([]string, []string, [][]int) {
+	if other == "" {
+		return options, headers, positions
+	}
+	if positions != nil {
+		positions = append(positions, nil)
+	}
+	return append(options, other), append(headers, ""), positions
+}
+
+// accessibleOther asks for the answer to type in place of the options until it isn't empty
+func accessibleOther(renderer *core.Renderer, funcs map[string]interface{}, rr *terminal.RuneReader) (string, error) {
+	for {
+		err := renderer.RenderWithFuncs(OtherAccessibleTemplate, funcs, nil)
+		if err != nil {
+			return "", err
+		}
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+		if answer := strings.TrimSpace(string(line)); answer != "" {
+			return answer, nil
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestOtherAnswer(t *testing.T) {
+	other := &otherAnswer{}
+	other.start("pod")
+	for _, key := range "cats " {
+		other.edit(key)
+	}
+	assert.Equal(t, "podcats", other.answer())
+
+	other.edit(terminal.KeyBackspace)
+	other.edit(terminal.KeyBackspace)
+	assert.Equal(t, "podcat", other.answer())
+
+	other.edit(terminal.KeyDeleteLine)
+	assert.Equal(t, "", other.answer())
+	assert.True(t, other.typing)
+
+	other.edit(terminal.KeyEscape)
+	assert.False(t, other.typing)
+}
+
+func TestSelect_other(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Select{
+		Message: "How did you hear about us?",
+		Options: []string{"A friend", "A podcast"},
+		Other:   "Other…",
+	}
+
+	// the Other entry stays after the options matching the filter
+	for _, key := range "radio" {
+		prompt.OnChange(nil, 0, key)
+	}
+	data := prompt.questionData()
+	assert.Equal(t, []string{"Other…"}, data.PageEntries)
+
+	// picking it starts typing the answer from the filter
+	_, _, ok := prompt.OnChange(nil, 0, terminal.KeyEnter)
+	assert.False(t, ok)
+	prompt.OnChange(nil, 0, '!')
+	data = prompt.questionData()
+	assert.True(t, data.Typing)
+	assert.Equal(t, "radio!", data.OtherAnswer)
+
+	outputBuffer.Reset()
+	assert.Nil(t, prompt.render(data))
+	assert.Equal(t, "? How did you hear about us? radio  [Type your answer, esc to go back]\n❯ Other… radio!\n", outputBuffer.String())
+
+	line, _, ok := prompt.OnChange(nil, 0, terminal.KeyEnter)
+	assert.True(t, ok)
+	assert.Equal(t, "radio!", string(line))
+
+	// escape goes back to the options
+	prompt.OnChange(nil, 0, terminal.KeyEscape)
+	assert.False(t, prompt.questionData().Typing)
+}
+
+func TestMultiSelect_other(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &MultiSelect{
+		Message: "Pick your languages:",
+		Options: []string{"Go", "Rust"},
+		Other:   "Other…",
+		Max:     2,
+		checked: map[string]bool{"Go": true},
+	}
+
+	prompt.selectedIndex = 2
+	prompt.OnChange(nil, 0, terminal.KeySpace)
+	for _, key := range "Zig" {
+		prompt.OnChange(nil, 0, key)
+	}
+
+	outputBuffer.Reset()
+	assert.Nil(t, prompt.render(prompt.questionData()))
+	assert.Equal(t, `? Pick your languages:  [Type your answer, enter to add it, esc to go back] 1 selected (at most 2)
+  ◉  Go
+  ◯  Rust
+❯    Other… Zig
+`, outputBuffer.String())
+
+	// the answer is checked and listed with the options
+	prompt.OnChange(nil, 0, terminal.KeyEnter)
+	assert.Equal(t, []string{"Go", "Zig"}, prompt.checkedOptions())
+	assert.Equal(t, []string{"Go", "Rust", "Zig", "Other…"}, prompt.questionData().PageEntries)
+
+	// another answer doesn't fit
+	prompt.selectedIndex = 3
+	prompt.OnChange(nil, 0, terminal.KeySpace)
+	for _, key := range "Odin" {
+		prompt.OnChange(nil, 0, key)
+	}
+	prompt.OnChange(nil, 0, terminal.KeyEnter)
+	assert.Equal(t, "at most 2 options can be selected", prompt.hint)
+	assert.True(t, prompt.other.typing)
+	assert.Equal(t, []string{"Go", "Zig"}, prompt.checkedOptions())
+
+	// checking every option leaves the Other entry out
+	prompt.OnChange(nil, 0, terminal.KeyEscape)
+	prompt.OnChange(nil, 0, terminal.KeyArrowLeft)
+	prompt.Max = 0
+	prompt.OnChange(nil, 0, terminal.KeyArrowRight)
+	assert.Equal(t, []string{"Go", "Rust", "Zig"}, prompt.checkedOptions())
+}
@@ -1,7 +1,10 @@
 package survey
 
 import (
-	"os"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
@@ -14,71 +17,371 @@ type is a string.
 	password := ""
 	prompt := &survey.Password{ Message: "Please type your password" }
 	survey.AskOne(prompt, &password, nil)
+
+Set Confirm to have the password typed a second time, which starts over until both match,
+and Strength to show how strong the password is below it while it is typed.
+
+	prompt := &survey.Password{
+		Message:  "Choose a password:",
+		Confirm:  true,
+		Strength: survey.PasswordStrength,
+	}
 */
 type Password struct {
 	core.Renderer
 	Message string
 	Help    string
+	// Confirm asks for the password a second time with ConfirmMessage, starting over
+	// when the two don't match
+	Confirm        bool
+	ConfirmMessage string
+	// Mask is shown for every character typed, '*' by default. NoEcho shows nothing at all.
+	Mask   rune
+	NoEcho bool
+	// Strength scores the password as it is typed, from 0 (very weak) to 4 (strong), to
+	// show a meter below it. PasswordStrength can be used for a simple score.
+	Strength func(password string) int
+	// MaskedSummary shows a fixed number of mask characters as the answer once the
+	// password was accepted instead of leaving it out
+	MaskedSummary bool
+	Template      string
+	TemplateFuncs map[string]interface{}
+	value         []rune
+	first         string
+	confirming    bool
+	showingHelp   bool
 }
 
 type PasswordTemplateData struct {
 	Password
-	ShowHelp bool
+	// Value is the password being typed as it is shown, and FirstValue the password that
+	// is being confirmed
+	Value         string
+	FirstValue    string
+	Confirming    bool
+	ShowStrength  bool
+	StrengthBar   string
+	StrengthLabel string
+	StrengthColor string
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
 }
 
+// PasswordStrengthLabels describes the scores of a Password's Strength in the meter.
+var PasswordStrengthLabels = []string{"very weak", "weak", "fair", "good", "strong"}
+
 // Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
 var PasswordQuestionTemplate = `
 {{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
 {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
 {{- color "default+hb"}}{{ .Message }} {{color "reset"}}
-{{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}`
-
-func (p *Password) Prompt() (line interface{}, err error) {
-	// render the question template
-	out, err := core.RunTemplate(
-		PasswordQuestionTemplate,
-		PasswordTemplateData{Password: *p},
-	)
-	terminal.Print(out)
-	if err != nil {
-		return "", err
+{{- if .ShowAnswer}}{{color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else if .Confirming}}{{.FirstValue}}{{"\n"}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .ConfirmMessage }} {{color "reset"}}{{.Value}}
+{{- else}}
+  {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
+  {{- .Value}}
+  {{- if .ShowStrength}}{{"\n"}}{{color .StrengthColor}}{{.StrengthBar}} {{.StrengthLabel}}{{color "reset"}}{{end}}
+{{- end}}`
+
+// PasswordStrength is a simple score of a password for Password's Strength, from 0 to 4.
+// Long passwords mixing lower and upper case letters, digits and symbols score higher.
+func PasswordStrength(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	length := len([]rune(password))
+	if length < 6 {
+		return 0
 	}
 
-	rr := terminal.NewRuneReader(os.Stdin)
+	score := 0
+	for _, class := range []bool{lower, upper, digit, other} {
+		if class {
+			score++
+		}
+	}
+	// a single kind of character is weak no matter how long it is
+	score--
+	if length >= 10 {
+		score++
+	}
+	if length >= 14 {
+		score++
+	}
+
+	if score > 4 {
+		return 4
+	}
+	return score
+}
+
+// maskRune returns the character shown for every character typed
+func (p *Password) maskRune() rune {
+	if p.Mask == 0 {
+		return '*'
+	}
+	return p.Mask
+}
+
+// mask returns how the text typed is shown
+func (p *Password) mask(value []rune) string {
+	if p.NoEcho {
+		return ""
+	}
+	return strings.Repeat(string(p.maskRune()), len(value))
+}
+
+// confirmMessage returns the message asking for the password a second time
+func (p *Password) confirmMessage() string {
+	if p.ConfirmMessage == "" {
+		return "Confirm password:"
+	}
+	return p.ConfirmMessage
+}
+
+// OnChange is called on every keypress.
+func (p *Password) OnChange(key rune) {
+	switch {
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if len(p.value) > 0 {
+			p.value = p.value[:len(p.value)-1]
+		}
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		p.value = []rune{}
+	case terminal.IsPrintable(key):
+		p.value = append(p.value, key)
+	}
+}
+
+// enter is called when the user presses enter. It returns the password once it was
+// typed, and typed again if it has to be confirmed.
+func (p *Password) enter() (string, bool, error) {
+	value := string(p.value)
+	p.value = []rune{}
+
+	if p.confirming {
+		p.confirming = false
+		if value != p.first {
+			p.first = ""
+			return "", false, errors.New("the passwords don't match, please try again.")
+		}
+		return value, true, nil
+	}
+
+	if value == string(p.Config().HelpInputRune) && p.Help != "" {
+		p.showingHelp = true
+		return "", false, nil
+	}
+	if p.Confirm {
+		p.first = value
+		p.confirming = true
+		return "", false, nil
+	}
+	return value, true, nil
+}
+
+func (p *Password) Prompt() (interface{}, error) {
+	p.value = []rune{}
+	p.first = ""
+	p.confirming = false
+	p.showingHelp = false
+
+	rr := p.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 
-	// no help msg?  Just return any response
-	if p.Help == "" {
-		line, err := rr.ReadLine('*')
-		return string(line), err
+	// accessible prompts don't redraw on every key so the password is read as a line
+	if p.Config().Accessible {
+		return p.accessiblePrompt(rr)
+	}
+
+	err := p.redraw()
+	if err != nil {
+		return "", err
 	}
 
-	// process answers looking for help prompt answer
 	for {
-		line, err := rr.ReadLine('*')
+		r, _, err := rr.ReadRune()
 		if err != nil {
-			return string(line), err
+			return "", err
+		}
+		if r == terminal.KeyInterrupt {
+			p.RestoreCursor()
+			return "", terminal.InterruptErr
 		}
 
-		if string(line) == string(core.HelpInputRune) {
-			// terminal will echo the \n so we need to jump back up one row
-			terminal.CursorPreviousLine(1)
-
-			err = p.Render(
-				PasswordQuestionTemplate,
-				PasswordTemplateData{Password: *p, ShowHelp: true},
-			)
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			password, done, err := p.enter()
+			if done {
+				p.RestoreCursor()
+				return password, nil
+			}
 			if err != nil {
+				// start over, printing the error on top
+				if err := p.Error(err); err != nil {
+					return "", err
+				}
+			}
+		} else {
+			p.OnChange(r)
+		}
+
+		err = p.redraw()
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func (p *Password) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	for {
+		// every question is printed on its own line
+		data := p.questionData()
+		if p.confirming {
+			data.Message = p.confirmMessage()
+		}
+		data.Confirming = false
+		data.ShowStrength = false
+		err := p.render(data)
+		if err != nil {
+			return "", err
+		}
+
+		if p.NoEcho {
+			p.value, err = p.readHidden(rr)
+		} else {
+			p.value, err = rr.ReadLine(p.maskRune())
+		}
+		if err != nil {
+			return "", err
+		}
+
+		password, done, err := p.enter()
+		if done {
+			return password, nil
+		}
+		if err != nil {
+			if err := p.Error(err); err != nil {
 				return "", err
 			}
-			continue
 		}
-		return string(line), err
 	}
 }
 
-// Cleanup hides the string with a fixed number of characters.
-func (prompt *Password) Cleanup(val interface{}) error {
-	return nil
+// readHidden reads a line without printing anything for the characters typed
+func (p *Password) readHidden(rr *terminal.RuneReader) ([]rune, error) {
+	line := []rune{}
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return line, err
+		}
+
+		switch {
+		case r == '\r' || r == '\n' || r == terminal.KeyEndTransmission:
+			fmt.Fprint(p.Stdio().Out, "\r\n")
+			return line, nil
+		case r == terminal.KeyInterrupt:
+			fmt.Fprint(p.Stdio().Out, "\r\n")
+			return line, terminal.InterruptErr
+		case r == terminal.KeyBackspace || r == terminal.KeyDelete:
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+			}
+		case terminal.IsPrintable(r):
+			line = append(line, r)
+		}
+	}
+}
+
+// redraw renders the password and moves the cursor back to its end, above the meter
+func (p *Password) redraw() error {
+	data := p.questionData()
+
+	up := 0
+	if data.ShowStrength {
+		up = 1
+	}
+	return p.RenderWithCursor(p.template(), p.TemplateFuncs, data, up, 0)
+}
+
+// Cleanup leaves the password out of the answer, or hides it behind a fixed number of
+// characters if MaskedSummary is set.
+func (p *Password) Cleanup(val interface{}) error {
+	answer := ""
+	if p.MaskedSummary {
+		answer = strings.Repeat(string(p.maskRune()), 8)
+	}
+
+	return p.render(PasswordTemplateData{
+		Password:   *p,
+		Answer:     answer,
+		ShowAnswer: true,
+	})
+}
+
+// questionData returns the data to render the password as it is typed
+func (p *Password) questionData() PasswordTemplateData {
+	password := *p
+	password.ConfirmMessage = p.confirmMessage()
+
+	data := PasswordTemplateData{
+		Password:   password,
+		Value:      p.mask(p.value),
+		FirstValue: p.mask([]rune(p.first)),
+		Confirming: p.confirming,
+		ShowHelp:   p.showingHelp,
+	}
+
+	if p.Strength != nil && !p.confirming && len(p.value) > 0 {
+		score := p.Strength(string(p.value))
+		if score < 0 {
+			score = 0
+		}
+		if score >= len(PasswordStrengthLabels) {
+			score = len(PasswordStrengthLabels) - 1
+		}
+
+		data.ShowStrength = true
+		data.StrengthBar = strings.Repeat("█", score+1) + strings.Repeat("░", len(PasswordStrengthLabels)-score-1)
+		data.StrengthLabel = PasswordStrengthLabels[score]
+		switch {
+		case score <= 1:
+			data.StrengthColor = "red"
+		case score == 2:
+			data.StrengthColor = "yellow"
+		default:
+			data.StrengthColor = "green"
+		}
+	}
+
+	return data
+}
+
+// template returns the Template of the prompt, or PasswordQuestionTemplate if it has none
+func (p *Password) template() string {
+	if p.Template != "" {
+		return p.Template
+	}
+	return PasswordQuestionTemplate
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of PasswordQuestionTemplate.
+func (p *Password) render(data PasswordTemplateData) error {
+	return p.RenderWithFuncs(p.template(), p.TemplateFuncs, data)
 }
@@ -1,10 +1,12 @@
 package survey
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
 )
 
 func init() {
@@ -51,3 +53,113 @@ func TestPasswordRender(t *testing.T) {
 		assert.Equal(t, test.expected, actual, test.title)
 	}
 }
+
+func TestPasswordRender_typing(t *testing.T) {
+	tests := []struct {
+		title    string
+		prompt   Password
+		expected string
+	}{
+		{
+			"Test Password masked output",
+			Password{Message: "Secret:", value: []rune("abc")},
+			"? Secret: ***",
+		},
+		{
+			"Test Password output with a custom mask",
+			Password{Message: "Secret:", Mask: '•', value: []rune("abc")},
+			"? Secret: •••",
+		},
+		{
+			"Test Password output without echo",
+			Password{Message: "Secret:", NoEcho: true, value: []rune("abc")},
+			"? Secret: ",
+		},
+		{
+			"Test Password output with the strength meter",
+			Password{Message: "Secret:", Strength: PasswordStrength, value: []rune("abcdef1")},
+			"? Secret: *******\n██░░░ weak",
+		},
+		{
+			"Test Password output while confirming",
+			Password{Message: "Secret:", Confirm: true, confirming: true, first: "abc", value: []rune("a")},
+			"? Secret: ***\n? Confirm password: *",
+		},
+	}
+
+	for _, test := range tests {
+		actual, err := core.RunTemplate(
+			PasswordQuestionTemplate,
+			test.prompt.questionData(),
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, actual, test.title)
+	}
+}
+
+func TestPasswordCleanup(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Password{Message: "Secret:"}
+	err := prompt.Cleanup("hunter2")
+	assert.Nil(t, err)
+	assert.Equal(t, "? Secret: \n", outputBuffer.String())
+
+	// the summary doesn't tell how long the password is
+	outputBuffer.Reset()
+	prompt = &Password{Message: "Secret:", MaskedSummary: true}
+	err = prompt.Cleanup("hunter2")
+	assert.Nil(t, err)
+	assert.Equal(t, "? Secret: ********\n", outputBuffer.String())
+}
+
+func TestPassword_confirm(t *testing.T) {
+	prompt := &Password{Confirm: true}
+
+	typeText := func(text string) {
+		for _, key := range text {
+			prompt.OnChange(key)
+		}
+	}
+
+	typeText("hunter2")
+	_, done, err := prompt.enter()
+	assert.False(t, done)
+	assert.Nil(t, err)
+	assert.True(t, prompt.confirming)
+
+	// a mismatch starts over
+	typeText("hunter3")
+	_, done, err = prompt.enter()
+	assert.False(t, done)
+	assert.NotNil(t, err)
+	assert.False(t, prompt.confirming)
+
+	typeText("hunter2")
+	prompt.enter()
+	typeText("hunter2")
+	password, done, err := prompt.enter()
+	assert.True(t, done)
+	assert.Nil(t, err)
+	assert.Equal(t, "hunter2", password)
+}
+
+func TestPasswordStrength(t *testing.T) {
+	tests := []struct {
+		password string
+		expected int
+	}{
+		{"", 0},
+		{"abc12", 0},
+		{"abcdefgh", 0},
+		{"abcdef12", 1},
+		{"abcDEF12", 2},
+		{"abcDEF12!", 3},
+		{"abcDEF12!xyz789", 4},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, PasswordStrength(test.password), test.password)
+	}
+}
@@ -0,0 +1,447 @@
+package survey
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Path is a prompt for a file system path. Tab completes the path being typed and lists
+the entries of its directory, which can then be browsed with the arrow keys: enter goes
+into the highlighted directory (or picks the highlighted file) and backspace after a
+separator goes back up. With Browse set the entries are listed from the start. Relative
+paths are relative to Root, or the working directory if it is empty. Response type is
+the path as typed, a string.
+
+	file := ""
+	prompt := &survey.Path{
+		Message:   "Which config file?",
+		OnlyFiles: true,
+		Patterns:  []string{"*.yaml", "*.yml"},
+		MustExist: true,
+	}
+	survey.AskOne(prompt, &file, nil)
+*/
+type Path struct {
+	core.Renderer
+	Message       string
+	Default       string
+	Help          string
+	Root          string
+	OnlyFiles     bool
+	OnlyDirs      bool
+	Patterns      []string
+	ShowHidden    bool
+	MustExist     bool
+	Browse        bool
+	PageSize      int
+	Template      string
+	TemplateFuncs map[string]interface{}
+	answer        []rune
+	index         int
+	entries       []string
+	selectedIndex int
+	showingHelp   bool
+}
+
+// data available to the templates when processing
+type PathTemplateData struct {
+	Path
+	Value         string
+	PageEntries   []string
+	SelectedIndex int
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var PathQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- if and .Help (not .ShowHelp)}}{{color "cyan"}}[{{ HelpInputRune }} for help]{{color "reset"}} {{end}}
+  {{- if .Default}}{{color "white"}}({{.Default}}) {{color "reset"}}{{end}}
+  {{- .Value}}
+  {{- if .PageEntries}}{{"\n"}}
+    {{- range $ix, $entry := .PageEntries}}
+      {{- if eq $ix $.SelectedIndex}}{{color "cyan+b"}}{{ SelectFocusIcon }} {{else}}{{color "default+hb"}}  {{end}}
+      {{- $entry}}
+      {{- color "reset"}}{{"\n"}}
+    {{- end}}
+  {{- end}}
+{{- end}}`
+
+// isSeparator returns true for the characters separating the directories of a path
+func isSeparator(r rune) bool {
+	return r == '/' || r == filepath.Separator
+}
+
+// split returns the directory part of the typed path, including its trailing separator,
+// and the name being typed in it
+func (p *Path) split() (dir string, name string) {
+	i := len(p.answer)
+	for i > 0 && !isSeparator(p.answer[i-1]) {
+		i--
+	}
+	return string(p.answer[:i]), string(p.answer[i:])
+}
+
+// resolve returns where a typed path is on the file system
+func (p *Path) resolve(path string) string {
+	if p.Root == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(p.Root, path)
+}
+
+// matches returns true if a file name matches one of the Patterns
+func (p *Path) matches(name string) bool {
+	if len(p.Patterns) == 0 {
+		return true
+	}
+	for _, pattern := range p.Patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// list returns the entries of the directory being typed that start with the name being
+// typed, directories end with a separator
+func (p *Path) list() []string {
+	dir, name := p.split()
+
+	lookIn := p.resolve(dir)
+	if lookIn == "" {
+		lookIn = "."
+	}
+	infos, err := ioutil.ReadDir(lookIn)
+	if err != nil {
+		return nil
+	}
+
+	entries := []string{}
+	for _, info := range infos {
+		switch {
+		case !strings.HasPrefix(info.Name(), name):
+			continue
+		// hidden files only show up when asked for or when their name is being typed
+		case strings.HasPrefix(info.Name(), ".") && !p.ShowHidden && !strings.HasPrefix(name, "."):
+			continue
+		case info.IsDir():
+			entries = append(entries, info.Name()+string(filepath.Separator))
+		// directories are kept to get to the files but files are not shown when looking for a directory
+		case !p.OnlyDirs && p.matches(info.Name()):
+			entries = append(entries, info.Name())
+		}
+	}
+	return entries
+}
+
+// refresh lists the entries for the typed path, with none of them highlighted
+func (p *Path) refresh() {
+	p.entries = p.list()
+	p.selectedIndex = -1
+}
+
+// hide stops listing the entries
+func (p *Path) hide() {
+	p.entries = nil
+	p.selectedIndex = -1
+}
+
+// OnChange is called on every keypress.
+func (p *Path) OnChange(key rune) {
+	switch {
+	case key == '\t':
+		p.complete()
+	case key == terminal.KeyArrowUp && len(p.entries) > 0:
+		p.selectedIndex--
+		if p.selectedIndex < 0 {
+			p.selectedIndex = len(p.entries) - 1
+		}
+	case key == terminal.KeyArrowDown && len(p.entries) > 0:
+		p.selectedIndex = (p.selectedIndex + 1) % len(p.entries)
+	case key == terminal.KeyEscape:
+		p.hide()
+	case key == terminal.KeyArrowLeft:
+		if p.index > 0 {
+			p.index--
+		}
+	case key == terminal.KeyArrowRight:
+		if p.index < len(p.answer) {
+			p.index++
+		}
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		p.delete()
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		p.setAnswer("")
+	case terminal.IsPrintable(key):
+		p.answer = append(p.answer[:p.index], append([]rune{key}, p.answer[p.index:]...)...)
+		p.index++
+		p.update()
+	}
+}
+
+// delete removes the character before the cursor. At the end of a directory that is the
+// whole directory so the user goes back up to its parent.
+func (p *Path) delete() {
+	if p.index == 0 {
+		return
+	}
+	if p.index == len(p.answer) && isSeparator(p.answer[p.index-1]) && (p.Browse || len(p.entries) > 0) {
+		end := len(p.answer) - 1
+		for end > 0 && !isSeparator(p.answer[end-1]) {
+			end--
+		}
+		p.setAnswer(string(p.answer[:end]))
+		return
+	}
+
+	p.answer = append(p.answer[:p.index-1], p.answer[p.index:]...)
+	p.index--
+	p.update()
+}
+
+// update keeps the entries in line with the text after it was edited
+func (p *Path) update() {
+	if p.Browse {
+		p.refresh()
+	} else {
+		p.hide()
+	}
+}
+
+// complete fills in the common prefix of the entries matching the typed path and lists
+// them, or highlights the next one if there is nothing left to fill in
+func (p *Path) complete() {
+	entries := p.list()
+	if len(entries) == 0 {
+		return
+	}
+
+	dir, name := p.split()
+	prefix := commonPrefix(entries)
+	switch {
+	case len(entries) == 1:
+		p.setAnswer(dir + prefix)
+		// a file is complete, a directory carries on with its entries
+		if !strings.HasSuffix(prefix, string(filepath.Separator)) {
+			p.hide()
+		}
+	case len([]rune(prefix)) > len([]rune(name)):
+		p.setAnswer(dir + prefix)
+		p.refresh()
+	case len(p.entries) > 0:
+		p.selectedIndex = (p.selectedIndex + 1) % len(p.entries)
+	default:
+		p.refresh()
+	}
+}
+
+// setAnswer replaces the text, puts the cursor at its end and lists the entries again
+func (p *Path) setAnswer(answer string) {
+	p.answer = []rune(answer)
+	p.index = len(p.answer)
+	if p.Browse || len(p.entries) > 0 {
+		p.refresh()
+	}
+}
+
+// pick puts the highlighted entry in the text, returning true if it was a directory that
+// was opened instead of a file answering the question
+func (p *Path) pick() bool {
+	dir, _ := p.split()
+	entry := p.entries[p.selectedIndex]
+
+	p.answer = []rune(dir + entry)
+	p.index = len(p.answer)
+	if strings.HasSuffix(entry, string(filepath.Separator)) {
+		p.refresh()
+		return true
+	}
+	p.hide()
+	return false
+}
+
+// check returns an error if the path does not fit the settings of the prompt
+func (p *Path) check(path string) error {
+	info, err := os.Stat(p.resolve(path))
+	if err != nil {
+		if p.MustExist || path == "" {
+			return fmt.Errorf("%q does not exist, please try again.", path)
+		}
+		// a new file still has to have the right name
+		if !p.OnlyDirs && !p.matches(filepath.Base(path)) {
+			return fmt.Errorf("%q does not match %v, please try again.", path, strings.Join(p.Patterns, ", "))
+		}
+		return nil
+	}
+
+	switch {
+	case p.OnlyFiles && info.IsDir():
+		return fmt.Errorf("%q is a directory, please pick a file.", path)
+	case p.OnlyDirs && !info.IsDir():
+		return fmt.Errorf("%q is not a directory, please pick a directory.", path)
+	case !info.IsDir() && !p.matches(info.Name()):
+		return fmt.Errorf("%q does not match %v, please try again.", path, strings.Join(p.Patterns, ", "))
+	}
+	return nil
+}
+
+func (p *Path) Prompt() (interface{}, error) {
+	p.answer = []rune{}
+	p.index = 0
+	p.showingHelp = false
+	p.update()
+
+	rr := p.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts don't redraw as the user types so there is no completion
+	if p.Config().Accessible {
+		return p.accessiblePrompt(rr)
+	}
+
+	err := p.redraw()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		if r == terminal.KeyInterrupt {
+			p.RestoreCursor()
+			return "", terminal.InterruptErr
+		}
+
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			switch {
+			// enter goes into the highlighted directory, a highlighted file is submitted
+			case p.selectedIndex >= 0 && p.pick():
+			case string(p.answer) == string(p.Config().HelpInputRune) && p.Help != "":
+				p.showingHelp = true
+				p.setAnswer("")
+			default:
+				val := string(p.answer)
+				if val == "" {
+					val = p.Default
+				}
+				err := p.check(val)
+				if err == nil {
+					p.RestoreCursor()
+					return val, nil
+				}
+				// we didn't get a valid answer, so print error and prompt again
+				if err := p.Error(err); err != nil {
+					return "", err
+				}
+			}
+		} else {
+			p.OnChange(r)
+		}
+
+		err = p.redraw()
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func (p *Path) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	err := p.render(PathTemplateData{Path: *p})
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+
+		showHelp := false
+		if string(line) == string(p.Config().HelpInputRune) && p.Help != "" {
+			showHelp = true
+		} else {
+			val := string(line)
+			if val == "" {
+				val = p.Default
+			}
+			err := p.check(val)
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := p.Error(err); err != nil {
+				return "", err
+			}
+		}
+
+		err = p.render(PathTemplateData{Path: *p, ShowHelp: showHelp})
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// redraw renders the path along with the entries and moves the cursor to where the user
+// is typing
+func (p *Path) redraw() error {
+	opts, idx := paginate(pageSize(p.PageSize, p.Config()), p.entries, p.selectedIndex)
+
+	// the path is on the last line, unless the entries are shown below it
+	up := 0
+	if len(opts) > 0 {
+		up = len(opts) + 1
+	}
+
+	return p.RenderWithCursor(
+		p.template(),
+		p.TemplateFuncs,
+		PathTemplateData{
+			Path:          *p,
+			Value:         string(p.answer),
+			PageEntries:   opts,
+			SelectedIndex: idx,
+			ShowHelp:      p.showingHelp,
+		},
+		up,
+		len(p.answer)-p.index,
+	)
+}
+
+func (p *Path) Cleanup(val interface{}) error {
+	return p.render(
+		PathTemplateData{Path: *p, Answer: val.(string), ShowAnswer: true},
+	)
+}
+
+// template returns the Template of the prompt, or PathQuestionTemplate if it has none
+func (p *Path) template() string {
+	if p.Template != "" {
+		return p.Template
+	}
+	return PathQuestionTemplate
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of PathQuestionTemplate.
+func (p *Path) render(data PathTemplateData) error {
+	return p.RenderWithFuncs(p.template(), p.TemplateFuncs, data)
+}
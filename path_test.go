@@ -0,0 +1,194 @@
+package survey
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+// pathTestRoot creates a directory with a few files and directories to browse
+func pathTestRoot(t *testing.T) string {
+	root, err := ioutil.TempDir("", "survey-path")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dir := range []string{"docs", "src/main", ".git"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{"README.md", "config.yaml", "docs/guide.md", "src/main/main.go", ".hidden"} {
+		if err := ioutil.WriteFile(filepath.Join(root, file), []byte{}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestPathRender(t *testing.T) {
+
+	tests := []struct {
+		title    string
+		prompt   Path
+		data     PathTemplateData
+		expected string
+	}{
+		{
+			"Test Path question output",
+			Path{Message: "Which file?"},
+			PathTemplateData{Value: "src/"},
+			"? Which file? src/",
+		},
+		{
+			"Test Path question output with entries",
+			Path{Message: "Which file?"},
+			PathTemplateData{Value: "src/", PageEntries: []string{"main/", "README.md"}, SelectedIndex: 0},
+			"? Which file? src/\n❯ main/\n  README.md\n",
+		},
+		{
+			"Test Path answer output",
+			Path{Message: "Which file?"},
+			PathTemplateData{Answer: "src/main.go", ShowAnswer: true},
+			"? Which file? src/main.go\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.data.Path = test.prompt
+		err := test.prompt.Render(
+			PathQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestPath_list(t *testing.T) {
+	root := pathTestRoot(t)
+	defer os.RemoveAll(root)
+	sep := string(filepath.Separator)
+
+	prompt := &Path{Root: root}
+	assert.Equal(t, []string{"README.md", "config.yaml", "docs" + sep, "src" + sep}, prompt.list())
+
+	// hidden files show up when asked for or typed
+	prompt = &Path{Root: root, ShowHidden: true}
+	assert.Equal(t, []string{".git" + sep, ".hidden", "README.md", "config.yaml", "docs" + sep, "src" + sep}, prompt.list())
+	prompt = &Path{Root: root}
+	prompt.setAnswer(".h")
+	assert.Equal(t, []string{".hidden"}, prompt.list())
+
+	// directories are kept to get to the files that match
+	prompt = &Path{Root: root, Patterns: []string{"*.md"}}
+	assert.Equal(t, []string{"README.md", "docs" + sep, "src" + sep}, prompt.list())
+
+	prompt = &Path{Root: root, OnlyDirs: true}
+	assert.Equal(t, []string{"docs" + sep, "src" + sep}, prompt.list())
+}
+
+func TestPath_complete(t *testing.T) {
+	root := pathTestRoot(t)
+	defer os.RemoveAll(root)
+	sep := string(filepath.Separator)
+
+	prompt := &Path{Root: root}
+	prompt.setAnswer("")
+
+	// a single match is filled in
+	prompt.OnChange('s')
+	prompt.OnChange('\t')
+	assert.Equal(t, "src"+sep, string(prompt.answer))
+	prompt.OnChange('\t')
+	assert.Equal(t, "src"+sep+"main"+sep, string(prompt.answer))
+	prompt.OnChange('\t')
+	assert.Equal(t, "src"+sep+"main"+sep+"main.go", string(prompt.answer))
+	assert.Nil(t, prompt.entries)
+
+	// several matches are listed and tab goes through them
+	prompt.OnChange(terminal.KeyDeleteLine)
+	prompt.OnChange('\t')
+	assert.Equal(t, "", string(prompt.answer))
+	assert.Equal(t, []string{"README.md", "config.yaml", "docs" + sep, "src" + sep}, prompt.entries)
+	assert.Equal(t, -1, prompt.selectedIndex)
+	prompt.OnChange('\t')
+	assert.Equal(t, 0, prompt.selectedIndex)
+
+	// typing hides them
+	prompt.OnChange('d')
+	assert.Nil(t, prompt.entries)
+}
+
+func TestPath_browse(t *testing.T) {
+	root := pathTestRoot(t)
+	defer os.RemoveAll(root)
+	sep := string(filepath.Separator)
+
+	prompt := &Path{Root: root, Browse: true}
+	prompt.update()
+	assert.Equal(t, []string{"README.md", "config.yaml", "docs" + sep, "src" + sep}, prompt.entries)
+
+	// enter goes into the highlighted directory
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.True(t, prompt.pick())
+	assert.Equal(t, "src"+sep, string(prompt.answer))
+	assert.Equal(t, []string{"main" + sep}, prompt.entries)
+
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.True(t, prompt.pick())
+	assert.Equal(t, "src"+sep+"main"+sep, string(prompt.answer))
+
+	// and picks a file
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.False(t, prompt.pick())
+	assert.Equal(t, "src"+sep+"main"+sep+"main.go", string(prompt.answer))
+
+	// backspace after a separator goes up a directory
+	prompt.setAnswer("src" + sep + "main" + sep)
+	prompt.OnChange(terminal.KeyBackspace)
+	assert.Equal(t, "src"+sep, string(prompt.answer))
+	prompt.OnChange(terminal.KeyBackspace)
+	assert.Equal(t, "", string(prompt.answer))
+}
+
+func TestPath_check(t *testing.T) {
+	root := pathTestRoot(t)
+	defer os.RemoveAll(root)
+
+	tests := []struct {
+		title  string
+		prompt Path
+		path   string
+		valid  bool
+	}{
+		{"accepts new files", Path{Root: root}, "new.txt", true},
+		{"refuses missing paths", Path{Root: root, MustExist: true}, "new.txt", false},
+		{"refuses directories for files", Path{Root: root, OnlyFiles: true}, "docs", false},
+		{"refuses files for directories", Path{Root: root, OnlyDirs: true}, "README.md", false},
+		{"accepts directories", Path{Root: root, OnlyDirs: true}, "docs", true},
+		{"refuses files not matching", Path{Root: root, Patterns: []string{"*.yaml"}}, "README.md", false},
+		{"refuses new files not matching", Path{Root: root, Patterns: []string{"*.yaml"}}, "new.txt", false},
+		{"accepts files matching", Path{Root: root, Patterns: []string{"*.yaml"}}, "config.yaml", true},
+	}
+
+	for _, test := range tests {
+		err := test.prompt.check(test.path)
+		assert.Equal(t, test.valid, err == nil, test.title)
+	}
+}
@@ -0,0 +1,211 @@
+package survey
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+)
+
+/*
+ProgressBar shows how far along a long operation is between questions. It is drawn with
+the same renderer, icons and colors as the prompts and is redrawn in place every time the
+progress changes, until Success or Fail replace it with a line saying how the operation went.
+
+	bar := &survey.ProgressBar{Message: "Uploading", Total: len(files)}
+	bar.Start()
+	for _, file := range files {
+		upload(file)
+		bar.Add(1)
+	}
+	bar.Success("Uploaded every file")
+
+Every method can be called from any goroutine, so the workers of an operation can report
+their progress directly.
+*/
+type ProgressBar struct {
+	core.Renderer
+	Message string
+	Total   int
+	// Width is the number of characters of the bar, 30 by default
+	Width         int
+	Template      string
+	TemplateFuncs map[string]interface{}
+	mu            sync.Mutex
+	current       int
+	reported      int
+	running       bool
+}
+
+// data available to the templates when processing
+type ProgressBarTemplateData struct {
+	Message string
+	Bar     string
+	Current int
+	Total   int
+	Percent int
+	// Done is set once the bar finished, and Failed when it finished with Fail
+	Done   bool
+	Failed bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var ProgressBarTemplate = `
+{{- if .Done}}
+  {{- if .Failed}}{{color "red"}}{{ ErrorIcon }}{{else}}{{color "green"}}{{ SuccessIcon }}{{end}} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+  {{- color "cyan"}}{{ .Bar }}{{color "reset"}} {{ .Percent }}% ({{ .Current }}/{{ .Total }}){{"\n"}}
+{{- end}}`
+
+// width returns the number of characters of the bar
+func (p *ProgressBar) width() int {
+	if p.Width <= 0 {
+		return 30
+	}
+	return p.Width
+}
+
+// Start draws the bar with no progress.
+func (p *ProgressBar) Start() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.Total <= 0 {
+		return errors.New("please provide a total to show the progress of")
+	}
+	if p.running {
+		return errors.New("the progress bar is already running")
+	}
+
+	p.current = 0
+	p.reported = 0
+	err := p.render(p.data())
+	if err != nil {
+		return err
+	}
+	p.running = true
+	return nil
+}
+
+// Set moves the progress to the given amount out of Total.
+func (p *ProgressBar) Set(current int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.set(current)
+}
+
+// Add moves the progress forward by the given amount.
+func (p *ProgressBar) Add(amount int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.set(p.current + amount)
+}
+
+// SetMessage changes the message shown next to the bar.
+func (p *ProgressBar) SetMessage(message string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Message = message
+	if !p.running {
+		return nil
+	}
+	return p.render(p.data())
+}
+
+// set moves the progress and redraws the bar
+func (p *ProgressBar) set(current int) error {
+	if current < 0 {
+		current = 0
+	}
+	if current > p.Total {
+		current = p.Total
+	}
+	if current == p.current || !p.running {
+		p.current = current
+		return nil
+	}
+	p.current = current
+
+	// accessible output is only ever appended so the progress is only reported every
+	// tenth of the way instead of on every change
+	if p.Config().Accessible {
+		tenth := p.percent() / 10
+		if tenth == p.reported {
+			return nil
+		}
+		p.reported = tenth
+	}
+	return p.render(p.data())
+}
+
+// Success replaces the bar with a line marked with SuccessIcon showing the message, or the
+// message of the bar if it is empty.
+func (p *ProgressBar) Success(message string) error {
+	return p.finish(message, false)
+}
+
+// Fail replaces the bar with a line marked with ErrorIcon showing the message, or the
+// message of the bar if it is empty.
+func (p *ProgressBar) Fail(message string) error {
+	return p.finish(message, true)
+}
+
+// finish draws the last line of the bar
+func (p *ProgressBar) finish(message string, failed bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.running = false
+	if message != "" {
+		p.Message = message
+	}
+	return p.render(ProgressBarTemplateData{
+		Message: p.Message,
+		Current: p.current,
+		Total:   p.Total,
+		Percent: p.percent(),
+		Done:    true,
+		Failed:  failed,
+	})
+}
+
+// percent returns how far along the progress is out of 100
+func (p *ProgressBar) percent() int {
+	if p.Total <= 0 {
+		return 0
+	}
+	return p.current * 100 / p.Total
+}
+
+// data returns the data to render the current progress
+func (p *ProgressBar) data() ProgressBarTemplateData {
+	width := p.width()
+	filled := 0
+	if p.Total > 0 {
+		filled = p.current * width / p.Total
+	}
+
+	return ProgressBarTemplateData{
+		Message: p.Message,
+		Bar:     strings.Repeat("█", filled) + strings.Repeat("░", width-filled),
+		Current: p.current,
+		Total:   p.Total,
+		Percent: p.percent(),
+	}
+}
+
+// render draws the bar with its own Template and TemplateFuncs when they are set instead
+// of ProgressBarTemplate.
+func (p *ProgressBar) render(data ProgressBarTemplateData) error {
+	tmpl := ProgressBarTemplate
+	if p.Template != "" {
+		tmpl = p.Template
+	}
+	return p.RenderWithFuncs(tmpl, p.TemplateFuncs, data)
+}
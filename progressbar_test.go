@@ -0,0 +1,100 @@
+package survey
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestProgressBarRender(t *testing.T) {
+
+	tests := []struct {
+		title    string
+		bar      *ProgressBar
+		current  int
+		expected string
+	}{
+		{
+			"Test ProgressBar output with no progress",
+			&ProgressBar{Message: "Uploading", Total: 4, Width: 8},
+			0,
+			"Uploading ░░░░░░░░ 0% (0/4)\n",
+		},
+		{
+			"Test ProgressBar output half way",
+			&ProgressBar{Message: "Uploading", Total: 4, Width: 8},
+			2,
+			"Uploading ████░░░░ 50% (2/4)\n",
+		},
+		{
+			"Test ProgressBar output with the default width",
+			&ProgressBar{Message: "Uploading", Total: 3},
+			1,
+			"Uploading ██████████░░░░░░░░░░░░░░░░░░░░ 33% (1/3)\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.bar.current = test.current
+		err := test.bar.render(test.bar.data())
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestProgressBar_progress(t *testing.T) {
+	out := bytes.NewBufferString("")
+	bar := &ProgressBar{Message: "Uploading", Total: 10, Width: 10}
+	bar.WithStdio(terminal.Stdio{Out: out})
+
+	assert.NotNil(t, (&ProgressBar{}).Start(), "a bar needs a total")
+	assert.Nil(t, bar.Start())
+	assert.Contains(t, out.String(), "Uploading ░░░░░░░░░░ 0% (0/10)\n")
+
+	// the workers report their progress at the same time
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bar.Add(2)
+		}()
+	}
+	wg.Wait()
+	assert.Contains(t, out.String(), "Uploading ████████░░ 80% (8/10)\n")
+
+	// the progress never goes past the total
+	assert.Nil(t, bar.Add(5))
+	assert.Contains(t, out.String(), "Uploading ██████████ 100% (10/10)\n")
+
+	assert.Nil(t, bar.Success("Uploaded"))
+	assert.Contains(t, out.String(), "✔ Uploaded\n")
+}
+
+func TestProgressBar_accessible(t *testing.T) {
+	out := bytes.NewBufferString("")
+	config := core.NewConfig()
+	config.Accessible = true
+	config.DisableColor = true
+
+	bar := &ProgressBar{Message: "Uploading", Total: 100, Width: 4}
+	bar.WithStdio(terminal.Stdio{Out: out})
+	bar.WithConfig(config)
+
+	assert.Nil(t, bar.Start())
+	for i := 0; i < 15; i++ {
+		assert.Nil(t, bar.Add(1))
+	}
+	assert.Nil(t, bar.Fail("The upload failed"))
+
+	// the progress is only printed every tenth of the way
+	assert.Equal(t, "Uploading ░░░░ 0% (0/100)\nUploading ░░░░ 10% (10/100)\n✘ The upload failed\n", out.String())
+}
@@ -0,0 +1,406 @@
+package survey
+
+import (
+	"errors"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Rank is a prompt that presents a list of options for the user to put in order. Space
+picks up the highlighted option, the arrow keys move it up and down the list and space
+drops it again. Typing filters the options, and a picked up option then moves past the
+ones that match. Response type is a slice of strings with every option in its new order.
+
+	regions := []string{}
+	prompt := &survey.Rank{
+		Message: "Order the regions to fail over to:",
+		Options: []string{"us-east", "us-west", "eu-west"},
+	}
+	survey.AskOne(prompt, &regions, nil)
+*/
+type Rank struct {
+	core.Renderer
+	Message       string
+	Options       []string
+	Default       []string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	// Filter matches the options to what the user typed, FuzzyFilter by default. The
+	// options matching it keep their order.
+	Filter        func(filter string, option string) *FilterMatch
+	Template      string
+	TemplateFuncs map[string]interface{}
+	// order holds the indexes of the options in their new order
+	order         []int
+	filter        string
+	selectedIndex int
+	holding       bool
+	showingHelp   bool
+}
+
+// RankOption is an option of a Rank prompt along with its place in the order.
+type RankOption struct {
+	Value string
+	// Position is the place of the option in the whole list, starting at 1
+	Position int
+}
+
+// the data available to the templates when processing
+type RankTemplateData struct {
+	Rank
+	PageEntries []RankOption
+	// PageMatches splits the entries of the page matching the filter into the parts that
+	// matched and the others, by the index of the entry
+	PageMatches   map[int][]OptionSegment
+	SelectedIndex int
+	Holding       bool
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+}
+
+var RankQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}
+  {{- if .Holding}}[Use arrows to move the option, space to drop it]
+  {{- else}}[Use arrows to move, space to pick up an option, type to filter
+    {{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]
+  {{- end}}{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $option := .PageEntries}}
+    {{- if eq $ix $.SelectedIndex}}
+      {{- if $.Holding}}{{color "yellow+b"}}{{else}}{{color "cyan+b"}}{{end}}{{ SelectFocusIcon }}{{" "}}
+    {{- else}}{{color "default+hb"}}  {{end}}
+    {{- $option.Position}}.{{" "}}
+    {{- with index $.PageMatches $ix}}
+      {{- range .}}{{if .Matched}}{{color "yellow+bu"}}{{.Text}}{{color "reset"}}
+        {{- if ne $ix $.SelectedIndex}}{{color "default+hb"}}{{else if $.Holding}}{{color "yellow+b"}}{{else}}{{color "cyan+b"}}{{end}}
+      {{- else}}{{.Text}}{{end}}{{end}}
+    {{- else}}{{$option.Value}}{{end}}
+    {{- color "reset"}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// RankAccessibleQuestionTemplate is used instead of RankQuestionTemplate when
+// core.Accessible is set. The options are only printed the first time the question
+// is asked and the user puts them in order by typing their numbers or names.
+var RankAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .PageEntries}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+  {{- range .PageEntries}}
+    {{- "  "}}{{ .Position }}. {{ .Value }}{{"\n"}}
+  {{- end}}
+{{- end}}
+{{- color "cyan"}}Type numbers or names separated by commas, first to last (empty keeps this order)
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
+// orderedOptions returns the options in their current order
+func (r *Rank) orderedOptions() []string {
+	options := make([]string, len(r.order))
+	for i, index := range r.order {
+		options[i] = r.Options[index]
+	}
+	return options
+}
+
+// shownOptions returns the places in the order of the options matching the filter along
+// with the positions of the characters that matched
+func (r *Rank) shownOptions() ([]int, [][]int) {
+	return filterIndexes(r.orderedOptions(), r.filter, r.Filter)
+}
+
+// move swaps the option that is picked up with the one shown next to it
+func (r *Rank) move(shown []int, step int) {
+	next := r.selectedIndex + step
+	if next < 0 || next >= len(shown) {
+		return
+	}
+
+	from, to := shown[r.selectedIndex], shown[next]
+	r.order[from], r.order[to] = r.order[to], r.order[from]
+	r.selectedIndex = next
+}
+
+// OnChange is called on every keypress.
+func (r *Rank) OnChange(key rune) {
+	options, _ := r.shownOptions()
+	oldFilter := r.filter
+
+	switch {
+	case key == terminal.KeySpace:
+		r.holding = !r.holding && r.selectedIndex >= 0 && r.selectedIndex < len(options)
+	case r.holding && (key == terminal.KeyArrowUp || (r.VimMode && key == 'k')):
+		r.move(options, -1)
+	case r.holding && (key == terminal.KeyArrowDown || (r.VimMode && key == 'j')):
+		r.move(options, 1)
+	case r.holding:
+		// the filter stays put while an option is picked up
+	case key == terminal.KeyArrowUp || (r.VimMode && key == 'k'):
+		// if we are at the top of the list go to the bottom
+		if len(options) == 0 {
+			// there is nothing to move to while no option matches the filter
+		} else if r.selectedIndex == 0 {
+			r.selectedIndex = len(options) - 1
+		} else {
+			r.selectedIndex--
+		}
+	case key == terminal.KeyArrowDown || (r.VimMode && key == 'j'):
+		// if we are at the bottom of the list go to the top
+		if len(options) == 0 {
+			// there is nothing to move to while no option matches the filter
+		} else if r.selectedIndex == len(options)-1 {
+			r.selectedIndex = 0
+		} else {
+			r.selectedIndex++
+		}
+	case key == r.Config().HelpInputRune && r.Help != "":
+		r.showingHelp = true
+	case key == terminal.KeyEscape:
+		r.VimMode = !r.VimMode
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		r.filter = ""
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if r.filter != "" {
+			r.filter = r.filter[0 : len(r.filter)-1]
+		}
+	case terminal.IsPrintable(key):
+		r.filter += string(key)
+		r.VimMode = false
+	}
+
+	r.FilterMessage = ""
+	if r.filter != "" {
+		r.FilterMessage = " " + r.filter
+	}
+	if oldFilter != r.filter {
+		// filter changed
+		options, _ = r.shownOptions()
+		r.selectedIndex = clampIndex(r.selectedIndex, len(options))
+	}
+}
+
+// defaultOrder returns the indexes of the options in the order of Default, followed by
+// the ones Default leaves out in the order of Options
+func (r *Rank) defaultOrder() []int {
+	return reorder(r.Options, r.Default)
+}
+
+// reorder returns the indexes of the options in the order of first, followed by the ones
+// first leaves out in their order. An option listed several times is taken in turn and
+// the values of first that aren't options are ignored.
+func reorder(options []string, first []string) []int {
+	order := []int{}
+	used := make([]bool, len(options))
+	for _, list := range [][]string{first, options} {
+		for _, option := range list {
+			for i, o := range options {
+				if o == option && !used[i] {
+					used[i] = true
+					order = append(order, i)
+					break
+				}
+			}
+		}
+	}
+	return order
+}
+
+// contains returns whether the list holds the value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rank) Prompt() (interface{}, error) {
+	// if there are no options to render
+	if len(r.Options) == 0 {
+		// we failed
+		return []string{}, errors.New("please provide options to rank")
+	}
+
+	r.order = r.defaultOrder()
+	r.filter = ""
+	r.FilterMessage = ""
+	r.selectedIndex = 0
+	r.holding = false
+	r.showingHelp = false
+
+	// accessible prompts are answered by typing instead of moving around the list
+	if r.Config().Accessible {
+		return r.accessiblePrompt()
+	}
+
+	// hide the cursor
+	r.NewCursor().Hide()
+	// show the cursor when we're done
+	defer r.NewCursor().Show()
+
+	err := r.render(r.questionData())
+	if err != nil {
+		return []string{}, err
+	}
+
+	rr := r.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// start waiting for input
+	for {
+		key, _, err := rr.ReadRune()
+		if err != nil {
+			return []string{}, err
+		}
+		if key == '\r' || key == '\n' || key == terminal.KeyEndTransmission {
+			break
+		}
+		if key == terminal.KeyInterrupt {
+			return []string{}, terminal.InterruptErr
+		}
+		r.OnChange(key)
+
+		err = r.render(r.questionData())
+		if err != nil {
+			return []string{}, err
+		}
+	}
+
+	r.filter = ""
+	r.FilterMessage = ""
+	r.holding = false
+
+	return r.orderedOptions(), nil
+}
+
+// accessiblePrompt prints the numbered list of options once and waits for the user to
+// type them in the order they want, without ever redrawing the prompt. Options left out
+// keep their order after the ones that were typed.
+func (r *Rank) accessiblePrompt() (interface{}, error) {
+	// print the question along with every option
+	err := r.RenderWithFuncs(
+		RankAccessibleQuestionTemplate,
+		r.TemplateFuncs,
+		RankTemplateData{Rank: *r, PageEntries: r.entries(r.allPlaces())},
+	)
+	if err != nil {
+		return []string{}, err
+	}
+
+	rr := r.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return []string{}, err
+		}
+		val := strings.TrimSpace(string(line))
+		showHelp := false
+
+		switch {
+		// an empty response keeps the order as it is
+		case val == "":
+			return r.orderedOptions(), nil
+		case val == string(r.Config().HelpInputRune) && r.Help != "":
+			showHelp = true
+		default:
+			choices, err := accessibleChoices(r.orderedOptions(), val)
+			if err == nil {
+				// the typed options go first, followed by the rest
+				options := r.orderedOptions()
+				order := []string{}
+				for _, i := range reorder(options, choices) {
+					order = append(order, options[i])
+				}
+				return order, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := r.Error(err); err != nil {
+				return []string{}, err
+			}
+		}
+
+		// ask again without repeating the list of options
+		err = r.RenderWithFuncs(
+			RankAccessibleQuestionTemplate,
+			r.TemplateFuncs,
+			RankTemplateData{Rank: *r, ShowHelp: showHelp},
+		)
+		if err != nil {
+			return []string{}, err
+		}
+	}
+}
+
+func (r *Rank) Cleanup(val interface{}) error {
+	return r.render(
+		RankTemplateData{
+			Rank:       *r,
+			Answer:     strings.Join(val.([]string), ", "),
+			ShowAnswer: true,
+		},
+	)
+}
+
+// allPlaces returns every place in the order
+func (r *Rank) allPlaces() []int {
+	places := make([]int, len(r.order))
+	for i := range places {
+		places[i] = i
+	}
+	return places
+}
+
+// entries pairs the options at the given places in the order with their position
+func (r *Rank) entries(places []int) []RankOption {
+	entries := make([]RankOption, len(places))
+	for i, place := range places {
+		entries[i] = RankOption{Value: r.Options[r.order[place]], Position: place + 1}
+	}
+	return entries
+}
+
+// questionData returns the data to render the visible page of the options
+func (r *Rank) questionData() RankTemplateData {
+	shown, positions := r.shownOptions()
+	options := make([]string, len(shown))
+	for i, place := range shown {
+		options[i] = r.Options[r.order[place]]
+	}
+	page, idx := paginate(pageSize(r.PageSize, r.Config()), options, r.selectedIndex)
+	start := r.selectedIndex - idx
+
+	return RankTemplateData{
+		Rank:          *r,
+		PageEntries:   r.entries(shown[start : start+len(page)]),
+		PageMatches:   pageSegments(page, positions, start),
+		SelectedIndex: idx,
+		Holding:       r.holding,
+		ShowHelp:      r.showingHelp,
+	}
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of RankQuestionTemplate.
+func (r *Rank) render(data RankTemplateData) error {
+	tmpl := RankQuestionTemplate
+	if r.Template != "" {
+		tmpl = r.Template
+	}
+	return r.RenderWithFuncs(tmpl, r.TemplateFuncs, data)
+}
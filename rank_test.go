@@ -0,0 +1,182 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+func TestRankRender(t *testing.T) {
+	prompt := Rank{
+		Message: "Order the regions:",
+		Options: []string{"us-east", "us-west", "eu-west"},
+		order:   []int{0, 1, 2},
+	}
+	holding := prompt
+	holding.selectedIndex = 1
+	holding.holding = true
+	filtered := prompt
+	filtered.filter = "west"
+	filtered.FilterMessage = " west"
+
+	tests := []struct {
+		title    string
+		data     RankTemplateData
+		expected string
+	}{
+		{
+			"Test Rank question output",
+			prompt.questionData(),
+			`? Order the regions:  [Use arrows to move, space to pick up an option, type to filter]
+❯ 1. us-east
+  2. us-west
+  3. eu-west
+`,
+		},
+		{
+			"Test Rank question output while moving an option",
+			holding.questionData(),
+			`? Order the regions:  [Use arrows to move the option, space to drop it]
+  1. us-east
+❯ 2. us-west
+  3. eu-west
+`,
+		},
+		{
+			"Test Rank question output with a filter",
+			filtered.questionData(),
+			`? Order the regions: west  [Use arrows to move, space to pick up an option, type to filter]
+❯ 2. us-west
+  3. eu-west
+`,
+		},
+		{
+			"Test Rank answer output",
+			RankTemplateData{Rank: prompt, Answer: "eu-west, us-east, us-west", ShowAnswer: true},
+			"? Order the regions: eu-west, us-east, us-west\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		err := prompt.Render(
+			RankQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestRank_defaultOrder(t *testing.T) {
+	prompt := &Rank{
+		Options: []string{"a", "b", "c", "d"},
+		Default: []string{"c", "x", "a"},
+	}
+	// defaults that aren't options are ignored and the rest keep their order
+	assert.Equal(t, []int{2, 0, 1, 3}, prompt.defaultOrder())
+}
+
+func TestRank_moveOption(t *testing.T) {
+	prompt := &Rank{Options: []string{"a", "b", "c", "d"}, order: []int{0, 1, 2, 3}}
+
+	// moving without picking up an option only moves the highlight
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, prompt.orderedOptions())
+
+	prompt.OnChange(terminal.KeySpace)
+	prompt.OnChange(terminal.KeyArrowDown)
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, []string{"a", "c", "d", "b"}, prompt.orderedOptions())
+	assert.Equal(t, 3, prompt.selectedIndex)
+
+	// the option stays at the bottom instead of wrapping around
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, []string{"a", "c", "d", "b"}, prompt.orderedOptions())
+
+	prompt.OnChange(terminal.KeySpace)
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, 0, prompt.selectedIndex)
+}
+
+func TestRank_moveFilteredOption(t *testing.T) {
+	prompt := &Rank{
+		Options: []string{"us-east", "eu-west", "us-west", "ap-south"},
+		Filter:  ContainsFilter,
+		order:   []int{0, 1, 2, 3},
+	}
+
+	for _, key := range "us" {
+		prompt.OnChange(key)
+	}
+	// typing is ignored while the option is picked up
+	prompt.OnChange(terminal.KeySpace)
+	prompt.OnChange('x')
+	assert.Equal(t, "us", prompt.filter)
+
+	// the option moves past the next one that matches
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, []string{"us-west", "eu-west", "us-east", "ap-south"}, prompt.orderedOptions())
+}
+
+func TestRank_moveWithoutMatches(t *testing.T) {
+	prompt := &Rank{Options: []string{"a", "b", "c"}, order: []int{0, 1, 2}}
+
+	// there is nothing to move to or pick up while no option matches
+	prompt.OnChange('z')
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, 0, prompt.selectedIndex)
+	prompt.OnChange(terminal.KeySpace)
+	assert.False(t, prompt.holding)
+
+	prompt.OnChange(terminal.KeyBackspace)
+	prompt.OnChange(terminal.KeySpace)
+	prompt.OnChange(terminal.KeyArrowDown)
+	assert.Equal(t, []string{"b", "a", "c"}, prompt.orderedOptions())
+}
+
+func TestRank_moveDuplicateOption(t *testing.T) {
+	prompt := &Rank{Options: []string{"a", "b", "a"}, order: []int{0, 1, 2}}
+
+	// the second of the options with the same name is the one moved
+	prompt.selectedIndex = 2
+	prompt.OnChange(terminal.KeySpace)
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, []int{0, 2, 1}, prompt.order)
+	assert.Equal(t, []RankOption{{"a", 1}, {"a", 2}, {"b", 3}}, prompt.questionData().PageEntries)
+}
+
+func TestRank_fuzzyFilter(t *testing.T) {
+	prompt := &Rank{
+		Message: "Order the regions:",
+		Options: []string{"us-east", "eu-west", "us-west"},
+		order:   []int{0, 1, 2},
+	}
+	for _, key := range "uwt" {
+		prompt.OnChange(key)
+	}
+
+	data := prompt.questionData()
+	// the options matching keep their order
+	assert.Equal(t, []RankOption{{"eu-west", 2}, {"us-west", 3}}, data.PageEntries)
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+	assert.Nil(t, prompt.render(data))
+	assert.Equal(t, `? Order the regions: uwt  [Use arrows to move, space to pick up an option, type to filter]
+❯ 2. eu-west
+  3. us-west
+`, outputBuffer.String())
+	assert.Equal(t, []OptionSegment{{"e", false}, {"u", true}, {"-", false}, {"w", true}, {"es", false}, {"t", true}}, data.PageMatches[0])
+}
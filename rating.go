@@ -0,0 +1,380 @@
+package survey
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Rating is a prompt that presents a horizontal scale, like 1 to 5 stars, a 0 to 10 score
+or the steps of a Likert scale, to pick a value from with the left and right arrows or
+by typing its number. The scale goes from Min to Max, 1 to 5 when both are zero, or
+through Labels when they are set. Response type is an int, or a string with the label
+of the step when Labels are set.
+
+	score := 0
+	prompt := &survey.Rating{
+		Message: "How likely are you to recommend us?",
+		Min:     0,
+		Max:     10,
+	}
+	survey.AskOne(prompt, &score, nil)
+*/
+type Rating struct {
+	core.Renderer
+	Message string
+	Min     int
+	Max     int
+	Labels  []string
+	// Stars draws the scale as a row of stars, filled up to the chosen value
+	Stars bool
+	// Default is the value chosen at the start, or the position of a label starting
+	// at 1. Zero leaves nothing chosen.
+	Default       int
+	Help          string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	value         int
+	chosen        bool
+	typed         string
+	showingHelp   bool
+}
+
+// RatingStep is a value of the scale of a Rating prompt.
+type RatingStep struct {
+	Value int
+	// Label is the label of the step, or its value when the prompt has no Labels
+	Label string
+	// Selected is set on the chosen step, and Filled on it and every step before it
+	Selected bool
+	Filled   bool
+}
+
+// data available to the templates when processing
+type RatingTemplateData struct {
+	Rating
+	Steps      []RatingStep
+	Value      string
+	Range      string
+	Answer     string
+	ShowAnswer bool
+	ShowHelp   bool
+}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var RatingQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }} {{color "reset"}}
+{{- if .ShowAnswer}}
+  {{- color "cyan"}}{{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else }}
+  {{- color "cyan"}}[Use arrows or type a number{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}{{"\n"}}
+  {{- if .Stars}}
+    {{- range .Steps}}{{if .Filled}}{{color "yellow"}}{{ RatingIcon }}{{else}}{{color "default"}}{{ EmptyRatingIcon }}{{end}}{{color "reset"}} {{end}}{{"\n"}}
+  {{- else}}
+    {{- range $ix, $step := .Steps}}{{if $ix}} {{end}}
+      {{- if $step.Selected}}{{color "cyan+b"}}[{{$step.Label}}]{{color "reset"}}{{else}} {{$step.Label}} {{end}}
+    {{- end}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// RatingAccessibleQuestionTemplate is used instead of RatingQuestionTemplate when
+// core.Accessible is set. The labels are only printed the first time the question is
+// asked and the user picks a value by typing it, or the number or name of a label.
+var RatingAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .Steps}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+  {{- if .Labels}}
+    {{- range .Steps}}{{"  "}}{{.Value}}. {{.Label}}{{"\n"}}{{end}}
+  {{- end}}
+{{- end}}
+{{- color "cyan"}}Type {{if .Labels}}a number or name{{else}}a number from {{.Range}}{{end}}
+{{- if .Value}} (default: {{.Value}}){{end}}
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
+// bounds returns the first and last value of the scale
+func (r *Rating) bounds() (int, int) {
+	if len(r.Labels) > 0 {
+		return 1, len(r.Labels)
+	}
+	if r.Min == 0 && r.Max == 0 {
+		return 1, 5
+	}
+	return r.Min, r.Max
+}
+
+// valid returns true if the value is on the scale
+func (r *Rating) valid(value int) bool {
+	first, last := r.bounds()
+	return value >= first && value <= last
+}
+
+// choose picks a value of the scale
+func (r *Rating) choose(value int) {
+	first, last := r.bounds()
+	if value < first {
+		value = first
+	}
+	if value > last {
+		value = last
+	}
+	r.value = value
+	r.chosen = true
+}
+
+// OnChange is called on every keypress.
+func (r *Rating) OnChange(key rune) {
+	first, _ := r.bounds()
+	typed := ""
+
+	switch {
+	case key == terminal.KeyArrowLeft:
+		if r.chosen {
+			r.choose(r.value - 1)
+		} else {
+			r.choose(first)
+		}
+	case key == terminal.KeyArrowRight:
+		if r.chosen {
+			r.choose(r.value + 1)
+		} else {
+			r.choose(first)
+		}
+	case key == r.Config().HelpInputRune && r.Help != "":
+		r.showingHelp = true
+	case key >= '0' && key <= '9':
+		// keep typing to reach the values with more than one digit
+		typed = r.typed + string(key)
+		if value, err := strconv.Atoi(typed); err != nil || !r.valid(value) {
+			typed = string(key)
+		}
+		if value, _ := strconv.Atoi(typed); r.valid(value) {
+			r.choose(value)
+		} else {
+			typed = ""
+		}
+	}
+
+	r.typed = typed
+}
+
+// label returns how a value is shown on the scale
+func (r *Rating) label(value int) string {
+	if len(r.Labels) > 0 {
+		return r.Labels[value-1]
+	}
+	return strconv.Itoa(value)
+}
+
+// answer returns the chosen value in the type of the answer
+func (r *Rating) answer() (interface{}, error) {
+	if !r.chosen {
+		return nil, errors.New("no value was picked, please try again.")
+	}
+	if len(r.Labels) > 0 {
+		return r.label(r.value), nil
+	}
+	return r.value, nil
+}
+
+// parse returns the value typed in accessible mode, which is a value of the scale or
+// the number or name of a label
+func (r *Rating) parse(val string) (int, error) {
+	if len(r.Labels) > 0 {
+		choice, err := accessibleChoice(r.Labels, val)
+		if err != nil {
+			return 0, err
+		}
+		for i, label := range r.Labels {
+			if label == choice {
+				return i + 1, nil
+			}
+		}
+	}
+
+	value, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid number, please try again.", val)
+	}
+	if !r.valid(value) {
+		return 0, fmt.Errorf("%v is not %v, please try again.", value, r.describeRange())
+	}
+	return value, nil
+}
+
+// describeRange returns a description of the scale for the templates
+func (r *Rating) describeRange() string {
+	first, last := r.bounds()
+	return fmt.Sprintf("%v to %v", first, last)
+}
+
+func (r *Rating) Prompt() (interface{}, error) {
+	first, last := r.bounds()
+	if first > last {
+		return nil, errors.New("please provide a scale to rate on")
+	}
+
+	r.chosen = false
+	r.typed = ""
+	r.showingHelp = false
+	if r.Default != 0 && r.valid(r.Default) {
+		r.choose(r.Default)
+	}
+
+	rr := r.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// accessible prompts don't redraw on every key so the value is typed as a line
+	if r.Config().Accessible {
+		return r.accessiblePrompt(rr)
+	}
+
+	// hide the cursor
+	r.NewCursor().Hide()
+	// show the cursor when we're done
+	defer r.NewCursor().Show()
+
+	err := r.render(r.questionData())
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		key, _, err := rr.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+		if key == terminal.KeyInterrupt {
+			return nil, terminal.InterruptErr
+		}
+		if key == '\r' || key == '\n' || key == terminal.KeyEndTransmission {
+			val, err := r.answer()
+			if err == nil {
+				return val, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := r.Error(err); err != nil {
+				return nil, err
+			}
+		} else {
+			r.OnChange(key)
+		}
+
+		err = r.render(r.questionData())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *Rating) accessiblePrompt(rr *terminal.RuneReader) (interface{}, error) {
+	err := r.renderAccessible(r.questionData())
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return nil, err
+		}
+		val := strings.TrimSpace(string(line))
+
+		switch {
+		// an empty response keeps the default
+		case val == "" && r.chosen:
+			return r.answer()
+		case val == string(r.Config().HelpInputRune) && r.Help != "":
+			r.showingHelp = true
+		default:
+			value, err := r.parse(val)
+			if err == nil {
+				r.choose(value)
+				return r.answer()
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := r.Error(err); err != nil {
+				return nil, err
+			}
+		}
+
+		// ask again without repeating the question and labels
+		data := r.questionData()
+		data.Steps = nil
+		err = r.renderAccessible(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (r *Rating) Cleanup(val interface{}) error {
+	answer := fmt.Sprint(val)
+	if value, ok := val.(int); ok && r.Stars {
+		first, last := r.bounds()
+		// a star for every step from the start of the scale
+		stars := value - first + 1
+		if stars < 0 {
+			stars = 0
+		}
+		answer = strings.Repeat(r.Config().RatingIcon, stars) + fmt.Sprintf(" (%v/%v)", value, last)
+	}
+
+	return r.render(RatingTemplateData{
+		Rating:     *r,
+		Answer:     answer,
+		ShowAnswer: true,
+	})
+}
+
+// questionData returns the data to render the scale with the chosen value
+func (r *Rating) questionData() RatingTemplateData {
+	first, last := r.bounds()
+
+	steps := []RatingStep{}
+	for value := first; value <= last; value++ {
+		steps = append(steps, RatingStep{
+			Value:    value,
+			Label:    r.label(value),
+			Selected: r.chosen && value == r.value,
+			Filled:   r.chosen && value <= r.value,
+		})
+	}
+
+	value := ""
+	if r.chosen {
+		value = r.label(r.value)
+	}
+
+	return RatingTemplateData{
+		Rating:   *r,
+		Steps:    steps,
+		Value:    value,
+		Range:    r.describeRange(),
+		ShowHelp: r.showingHelp,
+	}
+}
+
+// renderAccessible draws the prompt with RatingAccessibleQuestionTemplate
+func (r *Rating) renderAccessible(data RatingTemplateData) error {
+	return r.RenderWithFuncs(RatingAccessibleQuestionTemplate, r.TemplateFuncs, data)
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of RatingQuestionTemplate.
+func (r *Rating) render(data RatingTemplateData) error {
+	tmpl := RatingQuestionTemplate
+	if r.Template != "" {
+		tmpl = r.Template
+	}
+	return r.RenderWithFuncs(tmpl, r.TemplateFuncs, data)
+}
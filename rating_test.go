@@ -0,0 +1,131 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+func TestRatingRender(t *testing.T) {
+	likert := []string{"Disagree", "Neutral", "Agree"}
+
+	tests := []struct {
+		title    string
+		prompt   Rating
+		expected string
+	}{
+		{
+			"Test Rating question output",
+			Rating{Message: "Rate it:", value: 3, chosen: true},
+			"? Rate it: [Use arrows or type a number]\n 1   2  [3]  4   5 \n",
+		},
+		{
+			"Test Rating question output with stars",
+			Rating{Message: "Rate it:", Stars: true, value: 2, chosen: true},
+			"? Rate it: [Use arrows or type a number]\n★ ★ ☆ ☆ ☆ \n",
+		},
+		{
+			"Test Rating question output with labels and nothing chosen",
+			Rating{Message: "Agreed?", Labels: likert, Help: "Be honest"},
+			"? Agreed? [Use arrows or type a number, ? for more help]\n Disagree   Neutral   Agree \n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		err := test.prompt.Render(
+			RatingQuestionTemplate,
+			test.prompt.questionData(),
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestRatingRender_answer(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Rating{Message: "Rate it:", Stars: true}
+	err := prompt.Cleanup(4)
+	assert.Nil(t, err)
+	assert.Equal(t, "? Rate it: ★★★★ (4/5)\n", outputBuffer.String())
+}
+
+func TestRating_cleanupStarsFromNegativeMin(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Rating{Message: "Rate it:", Stars: true, Min: -2, Max: 2}
+	err := prompt.Cleanup(-1)
+	assert.Nil(t, err)
+	assert.Equal(t, "? Rate it: ★★ (-1/2)\n", outputBuffer.String())
+
+	// a value below the scale has no stars
+	outputBuffer.Reset()
+	err = prompt.Cleanup(-5)
+	assert.Nil(t, err)
+	assert.Equal(t, "? Rate it:  (-5/2)\n", outputBuffer.String())
+}
+
+func TestRating_keys(t *testing.T) {
+	prompt := &Rating{Min: 0, Max: 10}
+
+	// nothing is chosen until a key is pressed
+	_, err := prompt.answer()
+	assert.NotNil(t, err)
+
+	steps := []struct {
+		key      rune
+		expected int
+	}{
+		{terminal.KeyArrowRight, 0},
+		{terminal.KeyArrowRight, 1},
+		{terminal.KeyArrowLeft, 0},
+		// the value stays on the scale
+		{terminal.KeyArrowLeft, 0},
+		{'7', 7},
+		// typing more digits reaches the larger values
+		{'1', 1},
+		{'0', 10},
+		{'4', 4},
+	}
+
+	for _, step := range steps {
+		prompt.OnChange(step.key)
+		val, err := prompt.answer()
+		assert.Nil(t, err)
+		assert.Equal(t, step.expected, val)
+	}
+}
+
+func TestRating_labels(t *testing.T) {
+	prompt := &Rating{Labels: []string{"Disagree", "Neutral", "Agree"}}
+
+	// digits out of the scale are ignored
+	prompt.OnChange('5')
+	assert.False(t, prompt.chosen)
+
+	prompt.OnChange('2')
+	val, err := prompt.answer()
+	assert.Nil(t, err)
+	assert.Equal(t, "Neutral", val)
+
+	value, err := prompt.parse("agree")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, value)
+
+	_, err = prompt.parse("maybe")
+	assert.NotNil(t, err)
+}
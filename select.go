@@ -1,9 +1,12 @@
 package survey
 
 import (
+	"context"
 	"errors"
-	"os"
+	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"gopkg.in/AlecAivazis/survey.v1/core"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
@@ -19,26 +22,126 @@ for them to select using the arrow keys and enter. Response type is a string.
 		Options: []string{"red", "blue", "green"},
 	}
 	survey.AskOne(prompt, &color, nil)
+
+Options can also be listed in Groups, each under a header that can't be picked.
+
+	prompt := &survey.Select{
+		Message: "Choose a project:",
+		Groups: []survey.OptionGroup{
+			{Header: "Recently used", Options: []string{"api", "web"}},
+			{Header: "All projects", Options: []string{"api", "cli", "docs", "web"}},
+		},
+	}
+
+Description gives every option a description shown beside it, or under the list for the
+highlighted option only with DescriptionBelow, and Preview shows longer content about the
+highlighted option under the list, like the contents of a file.
+
+	prompt := &survey.Select{
+		Message: "Choose a file:",
+		Options: files,
+		Preview: func(file string) string {
+			contents, _ := ioutil.ReadFile(file)
+			return string(contents)
+		},
+	}
+
+A Source loads more options in the background while the prompt is shown, for lists too
+long to be given up front. The options can be picked and filtered as they arrive.
+
+	prompt := &survey.Select{
+		Message: "Choose a file:",
+		Source:  survey.ReaderSource(output),
+	}
+
+A Search is called with what the user typed instead of filtering the options, its results
+replacing them, for catalogs too large to be listed like the packages of a registry.
+
+	prompt := &survey.Select{
+		Message: "Choose a package:",
+		Search: func(ctx context.Context, query string, add func(options ...string)) error {
+			packages, err := registry.Search(ctx, query)
+			add(packages...)
+			return err
+		},
+	}
+
+Other adds an entry after the options for the user to type an answer that isn't one of
+them, which goes through the Validate of the question like any other answer.
+
+	prompt := &survey.Select{
+		Message: "How did you hear about us?",
+		Options: []string{"A friend", "A search engine", "A podcast"},
+		Other:   "Other…",
+	}
 */
 type Select struct {
 	core.Renderer
-	Message       string
-	Options       []string
+	Message string
+	Options []string
+	// Groups are listed after the Options, each under its header
+	Groups []OptionGroup
+	// Source loads more options, listed after the Options and Groups, while the prompt
+	// is shown
+	Source OptionSource
+	// Search is called with what the user typed, SearchDelay after they stopped typing,
+	// and calls add with the options matching it in place of the Options, Groups and
+	// Source. Its ctx is done once the user types something else.
+	Search      func(ctx context.Context, query string, add func(options ...string)) error
+	SearchDelay time.Duration
+	// Other is the label of an entry listed after the options to type an answer in place
+	// of picking one, none by default
+	Other         string
 	Default       string
 	Help          string
 	PageSize      int
 	VimMode       bool
 	FilterMessage string
+	// Filter matches the options to what the user typed, FuzzyFilter by default
+	Filter func(filter string, option string) *FilterMatch
+	// Description returns the description of an option, empty for none
+	Description func(option string) string
+	// DescriptionBelow shows the description of the highlighted option under the list
+	// instead of the description of every option beside it
+	DescriptionBelow bool
+	// Preview returns the content to show under the list for the highlighted option, of
+	// which the first PreviewHeight lines are shown, 10 by default
+	Preview       func(option string) string
+	PreviewHeight int
+	Template      string
+	TemplateFuncs map[string]interface{}
 	filter        string
 	selectedIndex int
 	useDefault    bool
 	showingHelp   bool
+	stream        *optionStream
+	filtered      *filterCache
+	search        *optionSearch
+	other         otherAnswer
 }
 
 // the data available to the templates when processing
 type SelectTemplateData struct {
 	Select
-	PageEntries   []string
+	PageEntries []string
+	// PageHeaders holds the headers to show above the entries of the page, by the index of
+	// the entry they go above
+	PageHeaders map[int]string
+	// PageMatches splits the entries of the page matching the filter into the parts that
+	// matched and the ones that didn't, by their index
+	PageMatches map[int][]OptionSegment
+	// PageDescriptions holds the descriptions of the entries of the page, by their index
+	PageDescriptions map[int]string
+	// PreviewLines are the lines of the preview of the highlighted option
+	PreviewLines []string
+	// Loading is set while options are still loaded from the Source or searched for, of
+	// which LoadError holds the error that stopped the loading if any
+	Loading   bool
+	LoadError string
+	// Typing is set while the user types the answer of the Other entry, which is
+	// OtherAnswer so far
+	Typing        bool
+	OtherAnswer   string
 	SelectedIndex int
 	Answer        string
 	ShowAnswer    bool
@@ -51,22 +154,75 @@ var SelectQuestionTemplate = `
 {{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else}}
-  {{- "  "}}{{- color "cyan"}}[Use arrows to move, type to filter{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}
+  {{- "  "}}{{- color "cyan"}}
+  {{- if .Typing}}[Type your answer, esc to go back]
+  {{- else}}[Use arrows to move, type to filter{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{end}}
+  {{- color "reset"}}
+  {{- if .Loading}}{{color "yellow"}} {{if .Search}}searching…{{else}}loading…{{end}}{{color "reset"}}{{end}}
   {{- "\n"}}
   {{- range $ix, $choice := .PageEntries}}
+    {{- with index $.PageHeaders $ix}}{{color "default+u"}}{{.}}{{color "reset"}}{{"\n"}}{{end}}
     {{- if eq $ix $.SelectedIndex}}{{color "cyan+b"}}{{ SelectFocusIcon }} {{else}}{{color "default+hb"}}  {{end}}
-    {{- $choice}}
+    {{- with index $.PageMatches $ix}}
+      {{- range .}}{{if .Matched}}{{color "yellow+bu"}}{{.Text}}{{color "reset"}}
+        {{- if eq $ix $.SelectedIndex}}{{color "cyan+b"}}{{else}}{{color "default+hb"}}{{end}}
+      {{- else}}{{.Text}}{{end}}{{end}}
+    {{- else}}{{$choice}}{{end}}
+    {{- if and $.Typing (eq $ix $.SelectedIndex)}} {{color "reset"}}{{color "cyan"}}{{$.OtherAnswer}}{{end}}
+    {{- if not $.DescriptionBelow}}{{with index $.PageDescriptions $ix}}{{color "reset"}}{{color "black+h"}} - {{.}}{{end}}{{end}}
     {{- color "reset"}}{{"\n"}}
   {{- end}}
+  {{- if .LoadError}}{{color "red"}}{{ ErrorIcon }} {{.LoadError}}{{color "reset"}}{{"\n"}}{{end}}
+  {{- if .DescriptionBelow}}{{with index .PageDescriptions .SelectedIndex}}{{color "black+h"}}{{.}}{{color "reset"}}{{"\n"}}{{end}}{{end}}
+  {{- if .PreviewLines}}{{color "cyan"}}────────{{color "reset"}}{{"\n"}}
+    {{- range .PreviewLines}}{{.}}{{"\n"}}{{end}}
+  {{- end}}
 {{- end}}`
 
+// SelectAccessibleQuestionTemplate is used instead of SelectQuestionTemplate when
+// core.Accessible is set. The options are only printed the first time the question
+// is asked and the user picks one by typing its number or name.
+var SelectAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .PageEntries}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+  {{- range $ix, $choice := .PageEntries}}
+    {{- with index $.PageHeaders $ix}}{{.}}{{"\n"}}{{end}}
+    {{- "  "}}{{ inc $ix }}. {{$choice}}{{with index $.PageDescriptions $ix}} - {{.}}{{end}}{{"\n"}}
+  {{- end}}
+{{- end}}
+{{- color "cyan"}}Type a number or name{{if .Default}} (default: {{.Default}}){{end}}
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
+// the question asking for what to search for in accessible mode
+var SelectAccessibleSearchTemplate = `
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- color "cyan"}}Type what to search for:{{color "reset"}} `
+
 // OnChange is called on every keypress.
 func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPos int, ok bool) {
-	options := s.filterOptions()
+	options, _, _ := s.entries()
 	oldFilter := s.filter
 
+	// the keys go to the answer of the Other entry while it is typed
+	if s.other.typing {
+		if key == terminal.KeyEnter && s.other.answer() != "" {
+			return []rune(s.other.answer()), 0, true
+		}
+		s.other.edit(key)
+		s.render(s.questionData())
+		return []rune{}, 0, false
+	}
+
 	// if the user pressed the enter key
 	if key == terminal.KeyEnter {
+		if s.otherSelected() {
+			s.other.start(s.filter)
+			s.render(s.questionData())
+			return []rune{}, 0, false
+		}
 		if s.selectedIndex < len(options) {
 			return []rune(options[s.selectedIndex]), 0, true
 		}
@@ -74,9 +230,10 @@ func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPo
 	} else if key == terminal.KeyArrowUp || (s.VimMode && key == 'k') {
 		s.useDefault = false
 
-		// if we are at the top of the list
-		if s.selectedIndex == 0 {
-			// start from the button
+		if len(options) == 0 {
+			// there is nothing to move to while no option is shown
+		} else if s.selectedIndex == 0 {
+			// we are at the top of the list so start from the bottom
 			s.selectedIndex = len(options) - 1
 		} else {
 			// otherwise we are not at the top of the list so decrement the selected index
@@ -85,16 +242,17 @@ func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPo
 		// if the user pressed down or 'j' to emulate vim
 	} else if key == terminal.KeyArrowDown || (s.VimMode && key == 'j') {
 		s.useDefault = false
-		// if we are at the bottom of the list
-		if s.selectedIndex == len(options)-1 {
-			// start from the top
+		if len(options) == 0 {
+			// there is nothing to move to while no option is shown
+		} else if s.selectedIndex == len(options)-1 {
+			// we are at the bottom of the list so start from the top
 			s.selectedIndex = 0
 		} else {
 			// increment the selected index
 			s.selectedIndex++
 		}
 		// only show the help message if we have one
-	} else if key == core.HelpInputRune && s.Help != "" {
+	} else if key == s.Config().HelpInputRune && s.Help != "" {
 		s.showingHelp = true
 	} else if key == terminal.KeyEscape {
 		s.VimMode = !s.VimMode
@@ -104,7 +262,7 @@ func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPo
 		if s.filter != "" {
 			s.filter = s.filter[0 : len(s.filter)-1]
 		}
-	} else if key >= terminal.KeySpace {
+	} else if terminal.IsPrintable(key) {
 		s.filter += string(key)
 		s.VimMode = false
 	}
@@ -114,29 +272,20 @@ func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPo
 		s.FilterMessage = " " + s.filter
 	}
 	if oldFilter != s.filter {
-		// filter changed
-		options = s.filterOptions()
-		if len(options) > 0 && len(options) <= s.selectedIndex {
-			s.selectedIndex = len(options) - 1
+		// the filter is what to search for
+		if s.search != nil {
+			s.search.query(s.filter, s.searchDelay())
 		}
+		// filter changed
+		options, _, _ = s.entries()
+		// the options were ranked again so start over from the best match
+		s.selectedIndex = 0
 	}
 
-	// figure out the options and index to render
-
-	// TODO if we have started filtering and were looking at the end of a list
-	// and we have modified the filter then we should move the page back!
-	opts, idx := paginate(s.PageSize, options, s.selectedIndex)
+	s.selectedIndex = clampIndex(s.selectedIndex, len(options))
 
 	// render the options
-	s.Render(
-		SelectQuestionTemplate,
-		SelectTemplateData{
-			Select:        *s,
-			SelectedIndex: idx,
-			ShowHelp:      s.showingHelp,
-			PageEntries:   opts,
-		},
-	)
+	s.render(s.questionData())
 
 	// if we are not pressing ent
 	if len(options) <= s.selectedIndex {
@@ -145,23 +294,157 @@ func (s *Select) OnChange(line []rune, pos int, key rune) (newLine []rune, newPo
 	return []rune(options[s.selectedIndex]), 0, true
 }
 
-func (s *Select) filterOptions() []string {
-	filter := strings.ToLower(s.filter)
-	if filter == "" {
-		return s.Options
+// clampIndex keeps the index of the selected option within a list of the given length. It
+// is 0 for an empty list.
+func clampIndex(index int, length int) int {
+	if index >= length {
+		index = length - 1
+	}
+	if index < 0 {
+		return 0
+	}
+	return index
+}
+
+// filterOptions returns the options matching the filter, ranked by how well they match
+// within their group, along with the header of each of them, which leaves out the groups
+// without a match, and the positions of the characters that matched
+func (s *Select) filterOptions() ([]string, []string, [][]int) {
+	options, headers := s.allOptions()
+	// the search did the matching already
+	if s.Search != nil {
+		return options, headers, nil
 	}
-	answer := []string{}
-	for _, o := range s.Options {
-		if strings.Contains(strings.ToLower(o), filter) {
-			answer = append(answer, o)
+	// the options loaded so far are kept from being matched again on every key
+	if s.filtered != nil {
+		return s.filtered.apply(options, headers, s.filter, s.Filter)
+	}
+	return filterGroupedOptions(options, headers, s.filter, s.Filter)
+}
+
+// entries returns the filtered options followed by the Other entry if there is one
+func (s *Select) entries() ([]string, []string, [][]int) {
+	options, headers, positions := s.filterOptions()
+	return withOther(s.Other, options, headers, positions)
+}
+
+// otherSelected returns true if the Other entry is highlighted
+func (s *Select) otherSelected() bool {
+	options, _, _ := s.entries()
+	return s.Other != "" && s.selectedIndex == len(options)-1
+}
+
+// allOptions returns the options followed by the options of every group and the ones
+// loaded from the Source so far, or the results of the Search, along with the header of
+// each of them
+func (s *Select) allOptions() ([]string, []string) {
+	options, headers := []string{}, []string{}
+	if s.Search == nil {
+		options, headers = groupOptions(s.Options, s.Groups)
+	}
+	if s.stream != nil {
+		loaded, _, _ := s.stream.state()
+		options = append(options, loaded...)
+		headers = append(headers, make([]string, len(loaded))...)
+	}
+	return options, headers
+}
+
+// questionData returns the data to render the visible page of the options
+func (s *Select) questionData() SelectTemplateData {
+	options, headers, positions := s.entries()
+
+	// TODO if we have started filtering and were looking at the end of a list
+	// and we have modified the filter then we should move the page back!
+	opts, idx := paginate(pageSize(s.PageSize, s.Config()), options, s.selectedIndex)
+
+	var preview []string
+	if s.Preview != nil && idx < len(opts) {
+		preview = s.previewLines(opts[idx])
+	}
+
+	data := SelectTemplateData{
+		Select:           *s,
+		PageEntries:      opts,
+		PageHeaders:      pageHeaders(headers, s.selectedIndex-idx, len(opts)),
+		PageMatches:      pageSegments(opts, positions, s.selectedIndex-idx),
+		PageDescriptions: s.descriptions(opts),
+		PreviewLines:     preview,
+		Typing:           s.other.typing,
+		OtherAnswer:      string(s.other.text),
+		SelectedIndex:    idx,
+		ShowHelp:         s.showingHelp,
+	}
+	if s.stream != nil {
+		_, loaded, err := s.stream.state()
+		data.Loading = !loaded
+		if err != nil {
+			data.LoadError = err.Error()
+		}
+	}
+	return data
+}
+
+// descriptions returns the descriptions of the options by their index
+func (s *Select) descriptions(options []string) map[int]string {
+	descriptions := map[int]string{}
+	if s.Description == nil {
+		return descriptions
+	}
+	for i, option := range options {
+		if description := s.Description(option); description != "" {
+			descriptions[i] = description
 		}
 	}
-	return answer
+	return descriptions
+}
+
+// previewLines returns the lines of the preview of an option that fit in PreviewHeight
+func (s *Select) previewLines(option string) []string {
+	preview := strings.Replace(s.Preview(option), "\r\n", "\n", -1)
+	preview = strings.TrimRight(preview, "\n")
+	if preview == "" {
+		return nil
+	}
+
+	height := s.PreviewHeight
+	if height <= 0 {
+		height = 10
+	}
+	lines := strings.Split(preview, "\n")
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return lines
 }
 
 func (s *Select) Prompt() (interface{}, error) {
+	// start loading the options of the source in the background
+	s.stream, s.filtered, s.search = nil, nil, nil
+	switch {
+	case s.Search != nil:
+		s.stream = newOptionStream()
+		s.search = &optionSearch{stream: s.stream, search: s.Search}
+		// stop searching once the prompt is done
+		defer s.search.close()
+
+		// accessible prompts ask for the query on their own
+		if !s.Config().Accessible {
+			s.search.query(s.filter, 0)
+		}
+	case s.Source != nil:
+		ctx, cancel := context.WithCancel(context.Background())
+		// stop loading once the prompt is done
+		defer cancel()
+
+		s.stream = newOptionStream()
+		s.filtered = &filterCache{}
+		go s.stream.load(ctx, s.Source, 0)
+	}
+
+	options, _ := s.allOptions()
 	// if there are no options to render
-	if len(s.Options) == 0 {
+	if len(options) == 0 && s.stream == nil {
 		// we failed
 		return "", errors.New("please provide options to select from")
 	}
@@ -171,7 +454,7 @@ func (s *Select) Prompt() (interface{}, error) {
 	// if there is a default
 	if s.Default != "" {
 		// find the choice
-		for i, opt := range s.Options {
+		for i, opt := range options {
 			// if the option correponds to the default
 			if opt == s.Default {
 				// we found our initial value
@@ -183,32 +466,33 @@ func (s *Select) Prompt() (interface{}, error) {
 	}
 	// save the selected index
 	s.selectedIndex = sel
+	s.showingHelp = false
+	s.other = otherAnswer{}
 
-	// figure out the options and index to render
-	opts, idx := paginate(s.PageSize, s.Options, sel)
+	// accessible prompts are answered by typing instead of moving around the list
+	if s.Config().Accessible {
+		return s.accessiblePrompt()
+	}
 
 	// ask the question
-	err := s.Render(
-		SelectQuestionTemplate,
-		SelectTemplateData{
-			Select:        *s,
-			PageEntries:   opts,
-			SelectedIndex: idx,
-		},
-	)
+	err := s.render(s.questionData())
 	if err != nil {
 		return "", err
 	}
 
+	// draw the options as they arrive until the user is done
+	stopRedraw := s.startRedraw()
+	defer stopRedraw()
+
 	// hide the cursor
-	terminal.CursorHide()
+	s.NewCursor().Hide()
 	// show the cursor when we're done
-	defer terminal.CursorShow()
+	defer s.NewCursor().Show()
 
 	// by default, use the default value
 	s.useDefault = true
 
-	rr := terminal.NewRuneReader(os.Stdin)
+	rr := s.NewRuneReader()
 	rr.SetTermMode()
 	defer rr.RestoreTermMode()
 	// start waiting for input
@@ -218,6 +502,13 @@ func (s *Select) Prompt() (interface{}, error) {
 			return "", err
 		}
 		if r == '\r' || r == '\n' {
+			// the Other entry is typed into instead of being the answer
+			if s.other.typing && s.other.answer() == "" || !s.other.typing && s.otherSelected() {
+				s.stream.lock()
+				s.OnChange(nil, 0, terminal.KeyEnter)
+				s.stream.unlock()
+				continue
+			}
 			break
 		}
 		if r == terminal.KeyInterrupt {
@@ -226,13 +517,27 @@ func (s *Select) Prompt() (interface{}, error) {
 		if r == terminal.KeyEndTransmission {
 			break
 		}
+		s.stream.lock()
 		s.OnChange(nil, 0, r)
+		s.stream.unlock()
 	}
-	options := s.filterOptions()
+	stopRedraw()
+	s.search.close()
+
+	options, _, _ = s.filterOptions()
 	s.filter = ""
 	s.FilterMessage = ""
 
+	// the answer typed for the Other entry is the answer as if it were an option
+	if s.other.typing && s.other.answer() != "" {
+		val := s.other.answer()
+		s.other = otherAnswer{}
+		return val, err
+	}
+	s.other = otherAnswer{}
+
 	var val string
+	s.selectedIndex = clampIndex(s.selectedIndex, len(options))
 	// if we are supposed to use the default value
 	if s.useDefault || s.selectedIndex >= len(options) {
 		// if there is a default value
@@ -251,9 +556,201 @@ func (s *Select) Prompt() (interface{}, error) {
 	return val, err
 }
 
+// accessiblePrompt prints the numbered list of options once and waits for the user
+// to type the number or name of their choice, without ever redrawing the prompt.
+func (s *Select) accessiblePrompt() (interface{}, error) {
+	rr := s.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	if s.search != nil {
+		err := s.accessibleSearch(rr)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// the options are only printed once so they all have to be there
+	err := s.stream.wait()
+	options, headers := s.allOptions()
+	if len(options) == 0 {
+		if err != nil {
+			return "", err
+		}
+		return "", errors.New("please provide options to select from")
+	}
+	options, headers, _ = withOther(s.Other, options, headers, nil)
+
+	// print the question along with every option
+	err = s.RenderWithFuncs(
+		SelectAccessibleQuestionTemplate,
+		s.TemplateFuncs,
+		SelectTemplateData{
+			Select:           *s,
+			PageEntries:      options,
+			PageHeaders:      pageHeaders(headers, 0, len(options)),
+			PageDescriptions: s.descriptions(options),
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+		val := strings.TrimSpace(string(line))
+		showHelp := false
+
+		switch {
+		// an empty response picks the default, or the first option if there isn't one
+		case val == "":
+			if s.Default != "" {
+				return s.Default, nil
+			}
+			return options[0], nil
+		case val == string(s.Config().HelpInputRune) && s.Help != "":
+			showHelp = true
+		default:
+			choice, err := accessibleChoice(options, val)
+			// the Other entry asks for the answer
+			if err == nil && s.Other != "" && choice == s.Other {
+				return accessibleOther(&s.Renderer, s.TemplateFuncs, rr)
+			}
+			if err == nil {
+				return choice, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := s.Error(err); err != nil {
+				return "", err
+			}
+		}
+
+		// ask again without repeating the list of options
+		err = s.RenderWithFuncs(
+			SelectAccessibleQuestionTemplate,
+			s.TemplateFuncs,
+			SelectTemplateData{Select: *s, ShowHelp: showHelp},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// accessibleSearch asks what to search for until the search finds something
+func (s *Select) accessibleSearch(rr *terminal.RuneReader) error {
+	for {
+		err := s.RenderWithFuncs(SelectAccessibleSearchTemplate, s.TemplateFuncs, SelectTemplateData{Select: *s})
+		if err != nil {
+			return err
+		}
+
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return err
+		}
+		query := strings.TrimSpace(string(line))
+
+		s.search.query(query, 0)
+		err = s.stream.wait()
+		options, _ := s.allOptions()
+		switch {
+		case err != nil:
+			err = fmt.Errorf("%v, please try again.", err)
+		case len(options) == 0:
+			err = fmt.Errorf("nothing matches %q, please try again.", query)
+		default:
+			return nil
+		}
+		if err := s.Error(err); err != nil {
+			return err
+		}
+	}
+}
+
+// searchDelay returns how long to wait after the user stopped typing to search
+func (s *Select) searchDelay() time.Duration {
+	if s.SearchDelay <= 0 {
+		return 200 * time.Millisecond
+	}
+	return s.SearchDelay
+}
+
+// startRedraw draws the prompt again every time options arrive from the Source, at most
+// every 50 milliseconds, until the function it returns is called
+func (s *Select) startRedraw() func() {
+	if s.stream == nil {
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-s.stream.changed:
+			}
+
+			s.stream.lock()
+			select {
+			case <-stop:
+				// the prompt is done so its last render must stay
+			default:
+				// the options arriving can't be shown with the selection off the list
+				if s.selectedIndex < 0 {
+					s.selectedIndex = 0
+				}
+				// there is nobody to report the error to, the next redraw tries again
+				s.render(s.questionData())
+			}
+			s.stream.unlock()
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.stream.lock()
+			close(stop)
+			s.stream.unlock()
+		})
+	}
+}
+
+// OptionGroup is a set of options of a Select or MultiSelect listed under a header. The
+// header only describes the options, it can't be picked and moving through the options
+// skips it.
+type OptionGroup struct {
+	Header  string
+	Options []string
+}
+
+// groupOptions returns the options followed by the options of every group, along with
+// the header of each of them, which is empty for the options outside of a group
+func groupOptions(options []string, groups []OptionGroup) ([]string, []string) {
+	all := append([]string{}, options...)
+	headers := make([]string, len(options))
+	for _, group := range groups {
+		for _, option := range group.Options {
+			all = append(all, option)
+			headers = append(headers, group.Header)
+		}
+	}
+	return all, headers
+}
+
 func (s *Select) Cleanup(val interface{}) error {
-	return s.Render(
-		SelectQuestionTemplate,
+	return s.render(
 		SelectTemplateData{
 			Select:     *s,
 			Answer:     val.(string),
@@ -261,3 +758,13 @@ func (s *Select) Cleanup(val interface{}) error {
 		},
 	)
 }
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of SelectQuestionTemplate.
+func (s *Select) render(data SelectTemplateData) error {
+	tmpl := SelectQuestionTemplate
+	if s.Template != "" {
+		tmpl = s.Template
+	}
+	return s.RenderWithFuncs(tmpl, s.TemplateFuncs, data)
+}
@@ -2,6 +2,8 @@ package survey
 
 import (
 	"bytes"
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +27,9 @@ func TestSelectRender(t *testing.T) {
 	helpfulPrompt := prompt
 	helpfulPrompt.Help = "This is helpful"
 
+	searchPrompt := prompt
+	searchPrompt.Search = func(ctx context.Context, query string, add func(options ...string)) error { return nil }
+
 	tests := []struct {
 		title    string
 		prompt   Select
@@ -69,6 +74,106 @@ func TestSelectRender(t *testing.T) {
   bar
 ❯ baz
   buz
+`,
+		},
+		{
+			"Test Select question output with groups",
+			prompt,
+			SelectTemplateData{
+				SelectedIndex: 1,
+				PageEntries:   []string{"foo", "bar", "baz"},
+				PageHeaders:   map[int]string{0: "Recently used", 1: "All words"},
+			},
+			`? Pick your word:  [Use arrows to move, type to filter]
+Recently used
+  foo
+All words
+❯ bar
+  baz
+`,
+		},
+		{
+			"Test Select question output with matches",
+			Select{Message: "Pick your word:", FilterMessage: " bz"},
+			SelectTemplateData{
+				SelectedIndex: 0,
+				PageEntries:   []string{"baz", "buz"},
+				PageMatches: map[int][]OptionSegment{
+					0: {{Text: "b", Matched: true}, {Text: "a"}, {Text: "z", Matched: true}},
+					1: {{Text: "b", Matched: true}, {Text: "u"}, {Text: "z", Matched: true}},
+				},
+			},
+			`? Pick your word: bz  [Use arrows to move, type to filter]
+❯ baz
+  buz
+`,
+		},
+		{
+			"Test Select question output with descriptions",
+			prompt,
+			SelectTemplateData{
+				SelectedIndex:    0,
+				PageEntries:      []string{"foo", "bar"},
+				PageDescriptions: map[int]string{0: "the first word"},
+			},
+			`? Pick your word:  [Use arrows to move, type to filter]
+❯ foo - the first word
+  bar
+`,
+		},
+		{
+			"Test Select question output with the description below",
+			Select{Message: "Pick your word:", DescriptionBelow: true},
+			SelectTemplateData{
+				SelectedIndex:    0,
+				PageEntries:      []string{"foo", "bar"},
+				PageDescriptions: map[int]string{0: "the first word", 1: "the second word"},
+			},
+			`? Pick your word:  [Use arrows to move, type to filter]
+❯ foo
+  bar
+the first word
+`,
+		},
+		{
+			"Test Select question output with a preview",
+			prompt,
+			SelectTemplateData{
+				SelectedIndex: 1,
+				PageEntries:   []string{"foo", "bar"},
+				PreviewLines:  []string{"line 1", "line 2"},
+			},
+			`? Pick your word:  [Use arrows to move, type to filter]
+  foo
+❯ bar
+────────
+line 1
+line 2
+`,
+		},
+		{
+			"Test Select question output while loading",
+			prompt,
+			SelectTemplateData{SelectedIndex: 0, PageEntries: []string{"foo"}, Loading: true},
+			`? Pick your word:  [Use arrows to move, type to filter] loading…
+❯ foo
+`,
+		},
+		{
+			"Test Select question output while searching",
+			searchPrompt,
+			SelectTemplateData{SelectedIndex: 0, PageEntries: []string{"foo"}, Loading: true},
+			`? Pick your word:  [Use arrows to move, type to filter] searching…
+❯ foo
+`,
+		},
+		{
+			"Test Select question output with a load error",
+			prompt,
+			SelectTemplateData{SelectedIndex: 0, PageEntries: []string{"foo"}, LoadError: "the listing failed"},
+			`? Pick your word:  [Use arrows to move, type to filter]
+❯ foo
+✘ the listing failed
 `,
 		},
 	}
@@ -87,3 +192,151 @@ func TestSelectRender(t *testing.T) {
 		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
 	}
 }
+
+func TestSelectAccessibleRender(t *testing.T) {
+
+	prompt := Select{
+		Message: "Pick your word:",
+		Options: []string{"foo", "bar", "baz", "buz"},
+		Default: "baz",
+		Help:    "This is helpful",
+	}
+
+	tests := []struct {
+		title    string
+		data     SelectTemplateData
+		expected string
+	}{
+		{
+			"Test accessible Select question output",
+			SelectTemplateData{PageEntries: prompt.Options},
+			`? Pick your word:
+  1. foo
+  2. bar
+  3. baz
+  4. buz
+Type a number or name (default: baz), ? for more help: `,
+		},
+		{
+			"Test accessible Select question output when asking again",
+			SelectTemplateData{},
+			`Type a number or name (default: baz), ? for more help: `,
+		},
+		{
+			"Test accessible Select question output with groups",
+			SelectTemplateData{PageEntries: prompt.Options, PageHeaders: map[int]string{2: "More words"}},
+			`? Pick your word:
+  1. foo
+  2. bar
+More words
+  3. baz
+  4. buz
+Type a number or name (default: baz), ? for more help: `,
+		},
+		{
+			"Test accessible Select question output with help shown",
+			SelectTemplateData{ShowHelp: true},
+			`ⓘ This is helpful
+Type a number or name (default: baz): `,
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		test.data.Select = prompt
+		err := prompt.Render(
+			SelectAccessibleQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestSelectRender_customTemplate(t *testing.T) {
+	prompt := Select{
+		Message:  "Pick your word:",
+		Options:  []string{"foo", "bar"},
+		Template: `{{ bullet }} {{ .Message }}{{ range .PageEntries }} {{ . }}{{ end }}`,
+		TemplateFuncs: map[string]interface{}{
+			"bullet": func() string { return "*" },
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	err := prompt.render(SelectTemplateData{Select: prompt, PageEntries: prompt.Options})
+	assert.Nil(t, err)
+	assert.Equal(t, "* Pick your word: foo bar", outputBuffer.String())
+}
+
+func TestSelect_groups(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Select{
+		Message: "Pick a project:",
+		Options: []string{"new"},
+		Groups: []OptionGroup{
+			{Header: "Recently used", Options: []string{"api", "web"}},
+			{Header: "All projects", Options: []string{"api", "cli", "docs", "web"}},
+		},
+		PageSize: 3,
+	}
+
+	data := prompt.questionData()
+	assert.Equal(t, []string{"new", "api", "web"}, data.PageEntries)
+	assert.Equal(t, map[int]string{1: "Recently used"}, data.PageHeaders)
+
+	// moving down never stops on a header
+	prompt.OnChange(nil, 0, terminal.KeyArrowDown)
+	prompt.OnChange(nil, 0, terminal.KeyArrowDown)
+	line, _, _ := prompt.OnChange(nil, 0, terminal.KeyArrowDown)
+	assert.Equal(t, "api", string(line))
+
+	// the header of the group stays on top of a page starting in the middle of it
+	data = prompt.questionData()
+	assert.Equal(t, []string{"web", "api", "cli"}, data.PageEntries)
+	assert.Equal(t, map[int]string{0: "Recently used", 1: "All projects"}, data.PageHeaders)
+
+	// the groups without a match are left out
+	for _, key := range "cl" {
+		prompt.OnChange(nil, 0, key)
+	}
+	data = prompt.questionData()
+	assert.Equal(t, []string{"cli"}, data.PageEntries)
+	assert.Equal(t, map[int]string{0: "All projects"}, data.PageHeaders)
+}
+
+func TestSelect_preview(t *testing.T) {
+	prompt := &Select{
+		Message:     "Pick a file:",
+		Options:     []string{"short.txt", "long.txt", "empty.txt"},
+		Description: func(option string) string { return strings.TrimSuffix(option, ".txt") },
+		Preview: func(option string) string {
+			switch option {
+			case "short.txt":
+				return "one\r\ntwo\n"
+			case "long.txt":
+				return "1\n2\n3\n4"
+			}
+			return ""
+		},
+		PreviewHeight: 3,
+	}
+
+	data := prompt.questionData()
+	assert.Equal(t, map[int]string{0: "short", 1: "long", 2: "empty"}, data.PageDescriptions)
+	assert.Equal(t, []string{"one", "two"}, data.PreviewLines)
+
+	// the preview follows the highlighted option and is cut to its height
+	prompt.selectedIndex = 1
+	assert.Equal(t, []string{"1", "2", "3"}, prompt.questionData().PreviewLines)
+
+	prompt.selectedIndex = 2
+	assert.Nil(t, prompt.questionData().PreviewLines)
+}
@@ -0,0 +1,146 @@
+package survey
+
+import (
+	"errors"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+/*
+Session asks questions with its own settings, template cache and input/output instead
+of the package level ones, so that several questionnaires can run at the same time in
+a single process (for example one per connected terminal). The package level Ask and
+AskOne use a default session that follows the package level settings.
+
+	session := survey.NewSession(terminal.Stdio{In: tty, Out: tty, Err: tty})
+	session.Config.DisableColor = true
+
+	name := ""
+	session.AskOne(&survey.Input{Message: "What is your name?"}, &name, nil)
+*/
+type Session struct {
+	Stdio  terminal.Stdio
+	Config *core.Config
+}
+
+// the session used by the package level functions, a nil config makes the prompts
+// read the package level settings
+var defaultSession = &Session{}
+
+// NewSession returns a session reading from and writing to the given files, with a copy
+// of the current package level settings.
+func NewSession(stdio terminal.Stdio) *Session {
+	config := core.NewConfig()
+	config.PageSize = PageSize
+
+	return &Session{
+		Stdio:  stdio,
+		Config: config,
+	}
+}
+
+// configurable is implemented by the prompts embedding a core.Renderer so a session can
+// hand them its settings and files before asking them.
+type configurable interface {
+	WithStdio(terminal.Stdio)
+	WithConfig(*core.Config)
+}
+
+// AskOne is like the package level AskOne but uses the settings and files of the session.
+// It returns SkipErr if the question was skipped.
+func (s *Session) AskOne(p Prompt, response interface{}, v Validator) error {
+	err := s.ask([]*Question{{Prompt: p, Validate: v}}, response, true)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Ask is like the package level Ask but uses the settings and files of the session.
+func (s *Session) Ask(qs []*Question, response interface{}) error {
+	return s.ask(qs, response, false)
+}
+
+// ask asks the questions in turn, moving on to the next one when a question is skipped
+// unless reportSkip is set
+func (s *Session) ask(qs []*Question, response interface{}, reportSkip bool) error {
+
+	// if we weren't passed a place to record the answers
+	if response == nil {
+		// we can't go any further
+		return errors.New("cannot call Ask() with a nil reference to record the answers")
+	}
+
+	// go over every question
+questions:
+	for _, q := range qs {
+		// make sure the prompt renders with the settings of the session
+		if c, ok := q.Prompt.(configurable); ok {
+			c.WithStdio(s.Stdio)
+			c.WithConfig(s.Config)
+		}
+
+		// grab the user input and save it
+		ans, err := q.Prompt.Prompt()
+		// a skipped question is left without an answer
+		if err == SkipErr && !reportSkip {
+			continue questions
+		}
+		// if there was a problem
+		if err != nil {
+			return err
+		}
+
+		// if there is a validate handler for this question
+		if q.Validate != nil {
+			// wait for a valid response
+			for invalid := q.Validate(ans); invalid != nil; invalid = q.Validate(ans) {
+				err := q.Prompt.Error(invalid)
+				// if there was a problem
+				if err != nil {
+					return err
+				}
+
+				// ask for more input
+				ans, err = q.Prompt.Prompt()
+				if err == SkipErr && !reportSkip {
+					continue questions
+				}
+				// if there was a problem
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if q.Transform != nil {
+			// check if we have a transformer available, if so
+			// then try to acquire the new representation of the
+			// answer, if the resulting answer is not nil.
+			if newAns := q.Transform(ans); newAns != nil {
+				ans = newAns
+			}
+		}
+
+		// tell the prompt to cleanup with the validated value
+		q.Prompt.Cleanup(ans)
+
+		// if something went wrong
+		if err != nil {
+			// stop listening
+			return err
+		}
+
+		// add it to the map
+		err = core.WriteAnswer(response, q.Name, ans)
+		// if something went wrong
+		if err != nil {
+			return err
+		}
+
+	}
+	// return the response
+	return nil
+}
@@ -0,0 +1,110 @@
+package survey
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestSession_rendersWithItsOwnSettings(t *testing.T) {
+	icons := []string{">", "$"}
+	outputs := []*bytes.Buffer{{}, {}}
+
+	var wg sync.WaitGroup
+	for i := range icons {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			session := NewSession(terminal.Stdio{Out: outputs[i]})
+			session.Config.DisableColor = true
+			session.Config.QuestionIcon = icons[i]
+
+			for j := 0; j < 50; j++ {
+				prompt := &Input{Message: "What is your name?"}
+				prompt.WithStdio(session.Stdio)
+				prompt.WithConfig(session.Config)
+
+				err := prompt.render(InputTemplateData{Input: *prompt})
+				assert.Nil(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, icon := range icons {
+		assert.Equal(t, 50, strings.Count(outputs[i].String(), icon+" What is your name? "))
+	}
+}
+
+func TestSession_usesPageSizeOfConfig(t *testing.T) {
+	config := core.NewConfig()
+	config.PageSize = 3
+
+	assert.Equal(t, 3, pageSize(0, config))
+	assert.Equal(t, 5, pageSize(5, config))
+}
+
+// scriptedPrompt gives the answers it was set up with in turn
+type scriptedPrompt struct {
+	answers []interface{}
+	errs    []error
+	cleaned []interface{}
+}
+
+func (p *scriptedPrompt) Prompt() (interface{}, error) {
+	ans, err := p.answers[0], p.errs[0]
+	p.answers, p.errs = p.answers[1:], p.errs[1:]
+	return ans, err
+}
+
+func (p *scriptedPrompt) Cleanup(val interface{}) error {
+	p.cleaned = append(p.cleaned, val)
+	return nil
+}
+
+func (p *scriptedPrompt) Error(error) error {
+	return nil
+}
+
+func TestSession_skipsQuestions(t *testing.T) {
+	pie := &scriptedPrompt{answers: []interface{}{true}, errs: []error{SkipErr}}
+	cake := &scriptedPrompt{answers: []interface{}{false, true}, errs: []error{nil, SkipErr}}
+	name := &scriptedPrompt{answers: []interface{}{"Larry"}, errs: []error{nil}}
+	qs := []*Question{
+		{Name: "pie", Prompt: pie},
+		{Name: "cake", Prompt: cake, Validate: func(ans interface{}) error {
+			if ans != true {
+				return errors.New("cake is great, please try again.")
+			}
+			return nil
+		}},
+		{Name: "name", Prompt: name},
+	}
+
+	answers := map[string]interface{}{}
+	err := NewSession(terminal.Stdio{}).Ask(qs, &answers)
+
+	assert.Nil(t, err)
+	// the skipped questions are left without an answer
+	assert.Equal(t, map[string]interface{}{"name": "Larry"}, answers)
+	assert.Empty(t, pie.cleaned)
+	assert.Empty(t, cake.cleaned)
+	assert.Equal(t, []interface{}{"Larry"}, name.cleaned)
+}
+
+func TestSession_askOneReportsSkip(t *testing.T) {
+	pie := &scriptedPrompt{answers: []interface{}{true}, errs: []error{SkipErr}}
+
+	answer := false
+	err := NewSession(terminal.Stdio{}).AskOne(pie, &answer, nil)
+
+	assert.Equal(t, SkipErr, err)
+	assert.False(t, answer)
+}
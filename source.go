@@ -0,0 +1,271 @@
+package survey
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OptionSource loads the options of a Select in the background, so that the prompt can be
+// used before every option is there, for example when there are too many of them to be
+// listed up front.
+type OptionSource interface {
+	// Load calls add with the options as they become available and returns once every
+	// option was added, or when ctx is done because the prompt was answered. It is called
+	// from its own goroutine.
+	Load(ctx context.Context, add func(options ...string)) error
+}
+
+// OptionSourceFunc makes a function an OptionSource.
+type OptionSourceFunc func(ctx context.Context, add func(options ...string)) error
+
+// Load calls the function.
+func (f OptionSourceFunc) Load(ctx context.Context, add func(options ...string)) error {
+	return f(ctx, add)
+}
+
+// ReaderSource returns an OptionSource reading the options from r, one per line like fzf
+// reads its input. Empty lines are skipped.
+func ReaderSource(r io.Reader) OptionSource {
+	return OptionSourceFunc(func(ctx context.Context, add func(options ...string)) error {
+		scanner := bufio.NewScanner(r)
+		// leave room for long lines like the paths of a monorepo
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+		// the options are added in batches so the prompt isn't redrawn for every line
+		batch := []string{}
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			line := strings.TrimRight(scanner.Text(), "\r")
+			if line == "" {
+				continue
+			}
+			batch = append(batch, line)
+			if len(batch) == 1000 {
+				add(batch...)
+				batch = []string{}
+			}
+		}
+		add(batch...)
+		return scanner.Err()
+	})
+}
+
+// optionStream holds the options loaded from a source so far
+type optionStream struct {
+	mu      sync.Mutex
+	options []string
+	loaded  bool
+	err     error
+	// generation counts the sources the stream was restarted with, the options of the
+	// previous ones being ignored, and replace is set until the options of the current
+	// source replace the ones of the previous source
+	generation int
+	replace    bool
+	// changed is signaled when options were added or the loading finished
+	changed chan struct{}
+	// drawing is held while the prompt changes or draws, from the keys being pressed or
+	// from the options arriving
+	drawing sync.Mutex
+}
+
+func newOptionStream() *optionStream {
+	return &optionStream{changed: make(chan struct{}, 1)}
+}
+
+// load adds the options of the source until they were all added or ctx is done, unless
+// the stream was restarted since the given generation
+func (o *optionStream) load(ctx context.Context, source OptionSource, generation int) {
+	err := source.Load(ctx, func(options ...string) {
+		o.add(generation, options...)
+	})
+
+	o.mu.Lock()
+	if generation != o.generation {
+		o.mu.Unlock()
+		return
+	}
+	o.loaded = true
+	// the loading being stopped because the prompt is done isn't an error
+	if ctx.Err() == nil {
+		o.err = err
+	}
+	if o.replace {
+		// the source had no options at all
+		o.options, o.replace = nil, false
+	}
+	o.mu.Unlock()
+	o.signal()
+}
+
+// add appends the options of the given generation to the stream
+func (o *optionStream) add(generation int, options ...string) {
+	if len(options) == 0 {
+		return
+	}
+
+	o.mu.Lock()
+	if generation != o.generation {
+		o.mu.Unlock()
+		return
+	}
+	if o.replace {
+		o.options, o.replace = nil, false
+	}
+	o.options = append(o.options, options...)
+	o.mu.Unlock()
+	o.signal()
+}
+
+// invalidate ignores the options still arriving from the source and marks the stream as
+// loading again, keeping the options loaded so far
+func (o *optionStream) invalidate() int {
+	o.mu.Lock()
+	o.generation++
+	o.loaded, o.err = false, nil
+	generation := o.generation
+	o.mu.Unlock()
+	o.signal()
+	return generation
+}
+
+// restart loads the options of another source in the background, which replace the
+// options loaded so far as soon as the first of them arrives
+func (o *optionStream) restart(ctx context.Context, source OptionSource) {
+	generation := o.invalidate()
+	o.mu.Lock()
+	o.replace = true
+	o.mu.Unlock()
+
+	go o.load(ctx, source, generation)
+}
+
+// signal notifies that the stream changed, unless it was notified already
+func (o *optionStream) signal() {
+	select {
+	case o.changed <- struct{}{}:
+	default:
+	}
+}
+
+// state returns the options loaded so far, whether they all are, and the error that
+// stopped the loading if any
+func (o *optionStream) state() ([]string, bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	// the slice is only ever appended to so the options loaded so far never change
+	return o.options[:len(o.options):len(o.options)], o.loaded, o.err
+}
+
+// lock keeps the prompt from being redrawn as options arrive until unlock is called. It
+// does nothing without a stream, when the prompt has no Source.
+func (o *optionStream) lock() {
+	if o != nil {
+		o.drawing.Lock()
+	}
+}
+
+// unlock lets the prompt be redrawn again
+func (o *optionStream) unlock() {
+	if o != nil {
+		o.drawing.Unlock()
+	}
+}
+
+// wait returns once every option was loaded, with the error that stopped the loading if
+// any. It returns right away without a stream.
+func (o *optionStream) wait() error {
+	if o == nil {
+		return nil
+	}
+	for {
+		_, loaded, err := o.state()
+		if loaded {
+			return err
+		}
+		<-o.changed
+	}
+}
+
+// optionSearch runs the queries of a search one at a time, the results of the last query
+// going to the stream
+type optionSearch struct {
+	mu     sync.Mutex
+	stream *optionStream
+	search func(ctx context.Context, query string, add func(options ...string)) error
+	// queries counts the queries asked for so a query waiting for its delay knows when
+	// another one came after it
+	queries int
+	timer   *time.Timer
+	cancel  context.CancelFunc
+	closed  bool
+}
+
+// query cancels the query running and searches for the new one once the delay passed
+// without another query, so that a search isn't started for every key typed. The
+// results of the previous query are shown until the ones of the new query arrive.
+func (o *optionSearch) query(query string, delay time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return
+	}
+	o.stop()
+	o.stream.invalidate()
+
+	o.queries++
+	queries := o.queries
+	o.timer = time.AfterFunc(delay, func() {
+		o.start(query, queries)
+	})
+}
+
+// start searches for the query unless another query was asked for since
+func (o *optionSearch) start(query string, queries int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed || queries != o.queries {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+	o.stream.restart(ctx, OptionSourceFunc(func(ctx context.Context, add func(options ...string)) error {
+		return o.search(ctx, query, add)
+	}))
+}
+
+// stop cancels the query waiting for its delay or running
+func (o *optionSearch) stop() {
+	if o.timer != nil {
+		o.timer.Stop()
+	}
+	if o.cancel != nil {
+		o.cancel()
+	}
+}
+
+// close stops searching for good, keeping the results shown. It does nothing without a
+// search.
+func (o *optionSearch) close() {
+	if o == nil {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.closed = true
+	o.stop()
+	// the results that would still arrive could change the option picked
+	o.stream.invalidate()
+}
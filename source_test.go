@@ -0,0 +1,267 @@
+package survey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestReaderSource(t *testing.T) {
+	added := []string{}
+	source := ReaderSource(strings.NewReader("main.go\r\n\nsurvey.go\nselect.go"))
+	err := source.Load(context.Background(), func(options ...string) {
+		added = append(added, options...)
+	})
+
+	assert.Nil(t, err)
+	// empty lines are skipped
+	assert.Equal(t, []string{"main.go", "survey.go", "select.go"}, added)
+}
+
+func TestReaderSource_cancelled(t *testing.T) {
+	lines := []string{}
+	for i := 0; i < 5000; i++ {
+		lines = append(lines, fmt.Sprintf("file-%d.go", i))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	added := []string{}
+	source := ReaderSource(strings.NewReader(strings.Join(lines, "\n")))
+	err := source.Load(ctx, func(options ...string) {
+		added = append(added, options...)
+		// the prompt is answered after the first batch
+		cancel()
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, lines[:1000], added)
+}
+
+func TestFilterCache(t *testing.T) {
+	options := []string{"github", "gitlab", "bitbucket", "gadgetly", "git-log"}
+	headers := make([]string, len(options))
+	cache := &filterCache{}
+
+	// the options arrive a few at a time while the filter is typed
+	steps := []struct {
+		count  int
+		filter string
+	}{
+		{2, "g"},
+		{4, "g"},
+		{4, "gt"},
+		{5, "gt"},
+		{5, "g"},
+		{5, "gtl"},
+		{5, ""},
+		{5, "bit"},
+	}
+	for _, step := range steps {
+		answer, answerHeaders, positions := cache.apply(options[:step.count], headers[:step.count], step.filter, nil)
+		expected, expectedHeaders, expectedPositions := filterGroupedOptions(options[:step.count], headers[:step.count], step.filter, nil)
+
+		title := fmt.Sprintf("%d options filtered by %q", step.count, step.filter)
+		assert.Equal(t, expected, answer, title)
+		assert.Equal(t, expectedHeaders, answerHeaders, title)
+		assert.Equal(t, expectedPositions, positions, title)
+	}
+}
+
+func TestSelect_source(t *testing.T) {
+	batches := make(chan []string)
+	added := make(chan struct{})
+	prompt := &Select{
+		Message: "Choose a file:",
+		Options: []string{"README.md"},
+		Source: OptionSourceFunc(func(ctx context.Context, add func(options ...string)) error {
+			for batch := range batches {
+				add(batch...)
+				added <- struct{}{}
+			}
+			return errors.New("the listing failed")
+		}),
+	}
+	prompt.stream = newOptionStream()
+	prompt.filtered = &filterCache{}
+	done := make(chan struct{})
+	go func() {
+		prompt.stream.load(context.Background(), prompt.Source, 0)
+		close(done)
+	}()
+
+	data := prompt.questionData()
+	assert.True(t, data.Loading)
+	assert.Equal(t, []string{"README.md"}, data.PageEntries)
+
+	batches <- []string{"main.go", "select.go"}
+	<-added
+	batches <- []string{"survey.go"}
+	<-added
+	prompt.filter = "s"
+	data = prompt.questionData()
+	// the options loaded so far can already be filtered
+	assert.True(t, data.Loading)
+	assert.Equal(t, []string{"select.go", "survey.go"}, data.PageEntries)
+
+	close(batches)
+	<-done
+	data = prompt.questionData()
+	assert.False(t, data.Loading)
+	assert.Equal(t, "the listing failed", data.LoadError)
+	assert.Equal(t, []string{"select.go", "survey.go"}, data.PageEntries)
+}
+
+func TestOptionStream_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	stream := newOptionStream()
+	stream.load(ctx, OptionSourceFunc(func(ctx context.Context, add func(options ...string)) error {
+		add("main.go")
+		return ctx.Err()
+	}), 0)
+
+	options, loaded, err := stream.state()
+	assert.Equal(t, []string{"main.go"}, options)
+	assert.True(t, loaded)
+	// the loading being stopped by the prompt isn't reported
+	assert.Nil(t, err)
+	assert.Nil(t, stream.wait())
+}
+
+func TestSelect_search(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	var mu sync.Mutex
+	queries := []string{}
+	cancelled := []string{}
+	started := make(chan struct{})
+	prompt := &Select{
+		Message:     "Choose a package:",
+		SearchDelay: 20 * time.Millisecond,
+		Search: func(ctx context.Context, query string, add func(options ...string)) error {
+			mu.Lock()
+			queries = append(queries, query)
+			mu.Unlock()
+
+			switch query {
+			case "slow":
+				close(started)
+				<-ctx.Done()
+				mu.Lock()
+				cancelled = append(cancelled, query)
+				mu.Unlock()
+				// the results of a query cancelled are dropped
+				add("too late")
+				return ctx.Err()
+			case "bad":
+				return errors.New("the index is down")
+			}
+			add(query+"-cli", query+"-server")
+			return nil
+		},
+	}
+	prompt.stream = newOptionStream()
+	prompt.search = &optionSearch{stream: prompt.stream, search: prompt.Search}
+	defer prompt.search.close()
+
+	// the empty query is searched for right away
+	prompt.search.query("", 0)
+	assert.Nil(t, prompt.stream.wait())
+	assert.Equal(t, []string{"-cli", "-server"}, prompt.questionData().PageEntries)
+
+	// the keys typed quickly make a single query
+	for _, key := range "git" {
+		prompt.OnChange(nil, 0, key)
+	}
+	data := prompt.questionData()
+	assert.True(t, data.Loading)
+	// the previous results stay until the new ones arrive
+	assert.Equal(t, []string{"-cli", "-server"}, data.PageEntries)
+	assert.Nil(t, prompt.stream.wait())
+	assert.Equal(t, []string{"git-cli", "git-server"}, prompt.questionData().PageEntries)
+
+	// a query still running is cancelled by the next one
+	prompt.search.query("slow", 0)
+	<-started
+	prompt.search.query("bad", 0)
+	err := prompt.stream.wait()
+	assert.EqualError(t, err, "the index is down")
+	data = prompt.questionData()
+	assert.Equal(t, "the index is down", data.LoadError)
+	assert.Empty(t, data.PageEntries)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "git", "slow", "bad"}, queries)
+	assert.Equal(t, []string{"slow"}, cancelled)
+}
+
+func TestSelect_moveWithoutSearchResults(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	prompt := &Select{
+		Message: "Choose a package:",
+		Search: func(ctx context.Context, query string, add func(options ...string)) error {
+			return nil
+		},
+	}
+	prompt.stream = newOptionStream()
+	prompt.search = &optionSearch{stream: prompt.stream, search: prompt.Search}
+	defer prompt.search.close()
+	prompt.search.query("", 0)
+	assert.Nil(t, prompt.stream.wait())
+
+	// there is nothing to move to
+	for _, key := range []rune{terminal.KeyArrowUp, terminal.KeyArrowDown, terminal.KeyArrowUp} {
+		_, _, ok := prompt.OnChange(nil, 0, key)
+		assert.False(t, ok)
+		assert.Equal(t, 0, prompt.selectedIndex)
+	}
+	_, _, ok := prompt.OnChange(nil, 0, terminal.KeyEnter)
+	assert.False(t, ok)
+}
+
+func TestSelect_moveBeforeSourceLoads(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	batches := make(chan []string)
+	prompt := &Select{
+		Message: "Choose a file:",
+		Source: OptionSourceFunc(func(ctx context.Context, add func(options ...string)) error {
+			for batch := range batches {
+				add(batch...)
+			}
+			return nil
+		}),
+	}
+	prompt.stream = newOptionStream()
+	prompt.filtered = &filterCache{}
+	done := make(chan struct{})
+	go func() {
+		prompt.stream.load(context.Background(), prompt.Source, 0)
+		close(done)
+	}()
+
+	// nothing is listed yet
+	prompt.OnChange(nil, 0, terminal.KeyArrowUp)
+	assert.Equal(t, 0, prompt.selectedIndex)
+
+	batches <- []string{"main.go", "select.go"}
+	close(batches)
+	<-done
+	line, _, ok := prompt.OnChange(nil, 0, terminal.KeyArrowDown)
+	assert.True(t, ok)
+	assert.Equal(t, "select.go", string(line))
+}
@@ -0,0 +1,198 @@
+package survey
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+)
+
+/*
+Spinner shows that a long operation is running between questions. It is drawn with the
+same renderer, icons and colors as the prompts and keeps spinning on its own once started,
+until Success or Fail replace it with a line saying how the operation went.
+
+	spinner := &survey.Spinner{Message: "Downloading the release..."}
+	spinner.Start()
+	err := download()
+	if err != nil {
+		spinner.Fail("The download failed")
+		return err
+	}
+	spinner.Success("Downloaded the release")
+
+Every method can be called from any goroutine.
+*/
+type Spinner struct {
+	core.Renderer
+	Message string
+	// Frames are shown one after the other, SpinnerFrames by default
+	Frames []string
+	// Interval is how long each frame is shown, 100 milliseconds by default
+	Interval      time.Duration
+	Template      string
+	TemplateFuncs map[string]interface{}
+	mu            sync.Mutex
+	frame         int
+	running       bool
+	stop          chan struct{}
+	stopped       chan struct{}
+}
+
+// data available to the templates when processing
+type SpinnerTemplateData struct {
+	Message string
+	Frame   string
+	// Done is set once the spinner finished, and Failed when it finished with Fail
+	Done   bool
+	Failed bool
+}
+
+// SpinnerFrames are the frames of a Spinner that has none of its own.
+var SpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Templates with Color formatting. See Documentation: https://github.com/mgutz/ansi#style-format
+var SpinnerTemplate = `
+{{- if .Done}}
+  {{- if .Failed}}{{color "red"}}{{ ErrorIcon }}{{else}}{{color "green"}}{{ SuccessIcon }}{{end}} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- color "cyan"}}{{ .Frame }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+{{- end}}`
+
+// frames returns the frames the spinner goes through
+func (s *Spinner) frames() []string {
+	if len(s.Frames) == 0 {
+		return SpinnerFrames
+	}
+	return s.Frames
+}
+
+// interval returns how long each frame is shown
+func (s *Spinner) interval() time.Duration {
+	if s.Interval <= 0 {
+		return 100 * time.Millisecond
+	}
+	return s.Interval
+}
+
+// Start draws the spinner and keeps it spinning until Success or Fail is called.
+func (s *Spinner) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		return errors.New("the spinner is already running")
+	}
+
+	s.frame = 0
+	err := s.render(s.data())
+	if err != nil {
+		return err
+	}
+	s.running = true
+
+	// accessible output is never redrawn so the spinner stays put
+	if s.Config().Accessible {
+		return nil
+	}
+
+	// hide the cursor while spinning
+	s.NewCursor().Hide()
+
+	s.stop = make(chan struct{})
+	s.stopped = make(chan struct{})
+	go s.spin(s.stop, s.stopped)
+
+	return nil
+}
+
+// spin draws the next frame on every tick until stop is closed
+func (s *Spinner) spin(stop, stopped chan struct{}) {
+	defer close(stopped)
+
+	ticker := time.NewTicker(s.interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.frame++
+			// there is nobody to report the error to, the next frame tries again
+			s.render(s.data())
+			s.mu.Unlock()
+		}
+	}
+}
+
+// SetMessage changes the message shown next to the spinner.
+func (s *Spinner) SetMessage(message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Message = message
+	if !s.running {
+		return nil
+	}
+	return s.render(s.data())
+}
+
+// Success stops the spinner and replaces it with a line marked with SuccessIcon showing
+// the message, or the message of the spinner if it is empty.
+func (s *Spinner) Success(message string) error {
+	return s.finish(message, false)
+}
+
+// Fail stops the spinner and replaces it with a line marked with ErrorIcon showing the
+// message, or the message of the spinner if it is empty.
+func (s *Spinner) Fail(message string) error {
+	return s.finish(message, true)
+}
+
+// finish stops the spinner and draws its last line
+func (s *Spinner) finish(message string, failed bool) error {
+	s.mu.Lock()
+	stop, stopped := s.stop, s.stopped
+	s.stop, s.stopped = nil, nil
+	s.mu.Unlock()
+
+	// wait for the last frame to be drawn before drawing over it
+	if stop != nil {
+		close(stop)
+		<-stopped
+		defer s.NewCursor().Show()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running = false
+	if message != "" {
+		s.Message = message
+	}
+	return s.render(SpinnerTemplateData{Message: s.Message, Done: true, Failed: failed})
+}
+
+// data returns the data to render the current frame
+func (s *Spinner) data() SpinnerTemplateData {
+	frames := s.frames()
+	return SpinnerTemplateData{
+		Message: s.Message,
+		Frame:   frames[s.frame%len(frames)],
+	}
+}
+
+// render draws the spinner with its own Template and TemplateFuncs when they are set
+// instead of SpinnerTemplate.
+func (s *Spinner) render(data SpinnerTemplateData) error {
+	tmpl := SpinnerTemplate
+	if s.Template != "" {
+		tmpl = s.Template
+	}
+	return s.RenderWithFuncs(tmpl, s.TemplateFuncs, data)
+}
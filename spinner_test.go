@@ -0,0 +1,104 @@
+package survey
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func TestSpinnerRender(t *testing.T) {
+
+	tests := []struct {
+		title    string
+		data     SpinnerTemplateData
+		expected string
+	}{
+		{
+			"Test Spinner frame output",
+			SpinnerTemplateData{Message: "Downloading", Frame: "⠙"},
+			"⠙ Downloading\n",
+		},
+		{
+			"Test Spinner success output",
+			SpinnerTemplateData{Message: "Downloaded", Done: true},
+			"✔ Downloaded\n",
+		},
+		{
+			"Test Spinner failure output",
+			SpinnerTemplateData{Message: "The download failed", Done: true, Failed: true},
+			"✘ The download failed\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		spinner := &Spinner{}
+		err := spinner.render(test.data)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+// syncBuffer is a buffer that can be written to from the goroutine of a spinner while
+// the test reads it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestSpinner_spins(t *testing.T) {
+	out := &syncBuffer{}
+	spinner := &Spinner{Message: "Working", Frames: []string{"-", "+"}, Interval: time.Millisecond}
+	spinner.WithStdio(terminal.Stdio{Out: out})
+
+	assert.Nil(t, spinner.Start())
+	assert.NotNil(t, spinner.Start(), "a running spinner can't be started again")
+	time.Sleep(20 * time.Millisecond)
+	assert.Nil(t, spinner.SetMessage("Still working"))
+	assert.Nil(t, spinner.Success(""))
+
+	output := out.String()
+	assert.Contains(t, output, "- Working\n")
+	assert.Contains(t, output, "+ Working\n")
+	// the success line is drawn after the last frame
+	assert.True(t, strings.LastIndex(output, "✔ Still working\n") > strings.LastIndex(output, " Working\n"), output)
+}
+
+func TestSpinner_accessible(t *testing.T) {
+	out := &syncBuffer{}
+	config := core.NewConfig()
+	config.Accessible = true
+	config.DisableColor = true
+
+	spinner := &Spinner{Message: "Working", Frames: []string{"-"}, Interval: time.Millisecond}
+	spinner.WithStdio(terminal.Stdio{Out: out})
+	spinner.WithConfig(config)
+
+	assert.Nil(t, spinner.Start())
+	time.Sleep(5 * time.Millisecond)
+	assert.Nil(t, spinner.Fail("It broke"))
+
+	// the spinner is never redrawn
+	assert.Equal(t, "- Working\n✘ It broke\n", out.String())
+}
@@ -1,8 +1,6 @@
 package survey
 
 import (
-	"errors"
-
 	"gopkg.in/AlecAivazis/survey.v1/core"
 )
 
@@ -51,12 +49,7 @@ in the documentation. For example:
 
 */
 func AskOne(p Prompt, response interface{}, v Validator) error {
-	err := Ask([]*Question{{Prompt: p, Validate: v}}, response)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return defaultSession.AskOne(p, response, v)
 }
 
 /*
@@ -66,7 +59,8 @@ the field whose name matches the Name field on the corresponding question. Field
 should be something that can be casted from the response type designated in the
 documentation. Note, a survey tag can also be used to identify a Otherwise, a
 map[string]interface{} can be passed, responses will be written to the key with the
-matching name. For example:
+matching name. A question skipped, like a Confirm answered with its Skip label, is left
+without an answer. For example:
 
 	qs := []*survey.Question{
 		{
@@ -83,69 +77,16 @@ matching name. For example:
 	err := survey.Ask(qs, &answers)
 */
 func Ask(qs []*Question, response interface{}) error {
+	return defaultSession.Ask(qs, response)
+}
 
-	// if we weren't passed a place to record the answers
-	if response == nil {
-		// we can't go any further
-		return errors.New("cannot call Ask() with a nil reference to record the answers")
-	}
-
-	// go over every question
-	for _, q := range qs {
-		// grab the user input and save it
-		ans, err := q.Prompt.Prompt()
-		// if there was a problem
-		if err != nil {
-			return err
-		}
-
-		// if there is a validate handler for this question
-		if q.Validate != nil {
-			// wait for a valid response
-			for invalid := q.Validate(ans); invalid != nil; invalid = q.Validate(ans) {
-				err := q.Prompt.Error(invalid)
-				// if there was a problem
-				if err != nil {
-					return err
-				}
-
-				// ask for more input
-				ans, err = q.Prompt.Prompt()
-				// if there was a problem
-				if err != nil {
-					return err
-				}
-			}
-		}
-
-		if q.Transform != nil {
-			// check if we have a transformer available, if so
-			// then try to acquire the new representation of the
-			// answer, if the resulting answer is not nil.
-			if newAns := q.Transform(ans); newAns != nil {
-				ans = newAns
-			}
-		}
-
-		// tell the prompt to cleanup with the validated value
-		q.Prompt.Cleanup(ans)
-
-		// if something went wrong
-		if err != nil {
-			// stop listening
-			return err
-		}
-
-		// add it to the map
-		err = core.WriteAnswer(response, q.Name, ans)
-		// if something went wrong
-		if err != nil {
-			return err
-		}
-
+// pageSize returns the page size set on a prompt, falling back to the one of its config.
+// paginate uses the package default if neither of them are set.
+func pageSize(page int, config *core.Config) int {
+	if page != 0 {
+		return page
 	}
-	// return the response
-	return nil
+	return config.PageSize
 }
 
 // paginate returns a single page of choices given the page size, the total list of
@@ -196,3 +137,18 @@ func paginate(page int, choices []string, sel int) ([]string, int) {
 	// return the subset we care about and the index
 	return choices[start:end], cursor
 }
+
+// pageHeaders returns the headers to show above the entries of a page of options, given
+// the header of every option and the index of the first option of the page. A header is
+// shown above the first option of its group, and above the first entry of the page so
+// the group of the options on top is never lost.
+func pageHeaders(headers []string, start int, count int) map[int]string {
+	page := map[int]string{}
+	for i := 0; i < count; i++ {
+		header := headers[start+i]
+		if header != "" && (i == 0 || header != headers[start+i-1]) {
+			page[i] = header
+		}
+	}
+	return page
+}
@@ -116,3 +116,12 @@ func TestPagination_lastHalf(t *testing.T) {
 	// we should be at the bottom of the list
 	assert.Equal(t, 2, idx)
 }
+
+func TestPageHeaders(t *testing.T) {
+	headers := []string{"", "first", "first", "second", "second"}
+
+	assert.Equal(t, map[int]string{1: "first", 3: "second"}, pageHeaders(headers, 0, 5))
+	// the first entry of a page shows the header of its group
+	assert.Equal(t, map[int]string{0: "first", 1: "second"}, pageHeaders(headers, 2, 2))
+	assert.Equal(t, map[int]string{}, pageHeaders(headers, 0, 1))
+}
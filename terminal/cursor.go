@@ -5,60 +5,131 @@ package terminal
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
+	"unsafe"
 )
 
+// Cursor moves the cursor of the terminal that Out writes to. The zero value
+// writes to the standard output.
+type Cursor struct {
+	Out io.Writer
+}
+
+func (c *Cursor) out() io.Writer {
+	if c.Out == nil {
+		return os.Stdout
+	}
+	return c.Out
+}
+
+// Up moves the cursor n cells to up.
+func (c *Cursor) Up(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dA", n)
+}
+
+// Down moves the cursor n cells to down.
+func (c *Cursor) Down(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dB", n)
+}
+
+// Forward moves the cursor n cells to right.
+func (c *Cursor) Forward(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dC", n)
+}
+
+// Back moves the cursor n cells to left.
+func (c *Cursor) Back(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dD", n)
+}
+
+// NextLine moves cursor to beginning of the line n lines down.
+func (c *Cursor) NextLine(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dE", n)
+}
+
+// PreviousLine moves cursor to beginning of the line n lines up.
+func (c *Cursor) PreviousLine(n int) {
+	fmt.Fprintf(c.out(), "\x1b[%dF", n)
+}
+
+// HorizontalAbsolute moves cursor horizontally to x.
+func (c *Cursor) HorizontalAbsolute(x int) {
+	fmt.Fprintf(c.out(), "\x1b[%dG", x)
+}
+
+// Show shows the cursor.
+func (c *Cursor) Show() {
+	fmt.Fprint(c.out(), "\x1b[?25h")
+}
+
+// Hide hide the cursor.
+func (c *Cursor) Hide() {
+	fmt.Fprint(c.out(), "\x1b[?25l")
+}
+
+// Move moves the cursor to a specific x,y location.
+func (c *Cursor) Move(x int, y int) {
+	fmt.Fprintf(c.out(), "\x1b[%d;%df", x, y)
+}
+
+// EraseLine erases part of the line the cursor is on.
+func (c *Cursor) EraseLine(mode EraseLineMode) {
+	fmt.Fprintf(c.out(), "\x1b[%dK", mode)
+}
+
 // CursorUp moves the cursor n cells to up.
 func CursorUp(n int) {
-	fmt.Printf("\x1b[%dA", n)
+	(&Cursor{}).Up(n)
 }
 
 // CursorDown moves the cursor n cells to down.
 func CursorDown(n int) {
-	fmt.Printf("\x1b[%dB", n)
+	(&Cursor{}).Down(n)
 }
 
 // CursorForward moves the cursor n cells to right.
 func CursorForward(n int) {
-	fmt.Printf("\x1b[%dC", n)
+	(&Cursor{}).Forward(n)
 }
 
 // CursorBack moves the cursor n cells to left.
 func CursorBack(n int) {
-	fmt.Printf("\x1b[%dD", n)
+	(&Cursor{}).Back(n)
 }
 
 // CursorNextLine moves cursor to beginning of the line n lines down.
 func CursorNextLine(n int) {
-	fmt.Printf("\x1b[%dE", n)
+	(&Cursor{}).NextLine(n)
 }
 
 // CursorPreviousLine moves cursor to beginning of the line n lines up.
 func CursorPreviousLine(n int) {
-	fmt.Printf("\x1b[%dF", n)
+	(&Cursor{}).PreviousLine(n)
 }
 
 // CursorHorizontalAbsolute moves cursor horizontally to x.
 func CursorHorizontalAbsolute(x int) {
-	fmt.Printf("\x1b[%dG", x)
+	(&Cursor{}).HorizontalAbsolute(x)
 }
 
 // CursorShow shows the cursor.
 func CursorShow() {
-	fmt.Print("\x1b[?25h")
+	(&Cursor{}).Show()
 }
 
 // CursorHide hide the cursor.
 func CursorHide() {
-	fmt.Print("\x1b[?25l")
+	(&Cursor{}).Hide()
 }
 
 // CursorMove moves the cursor to a specific x,y location.
 func CursorMove(x int, y int) {
-	fmt.Printf("\x1b[%d;%df", x, y)
+	(&Cursor{}).Move(x, y)
 }
 
 // CursorLocation returns the current location of the cursor in the terminal
@@ -132,3 +203,22 @@ func Size() (*Coord, error) {
 	// is the dimensions we are looking for
 	return bottom, nil
 }
+
+// Width returns the number of columns of the terminal Out writes to, or 0 if Out is
+// not a terminal.
+func (c *Cursor) Width() int {
+	f, ok := c.out().(interface {
+		Fd() uintptr
+	})
+	if !ok {
+		return 0
+	}
+
+	var size struct {
+		rows, cols, x, y uint16
+	}
+	if _, _, err := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&size))); err != 0 {
+		return 0
+	}
+	return int(size.cols)
+}
@@ -1,29 +1,45 @@
 package terminal
 
 import (
+	"io"
 	"os"
 	"syscall"
 	"unsafe"
 )
 
-func CursorUp(n int) {
-	cursorMove(0, n)
+// Cursor moves the cursor of the console that Out writes to. The zero value, or
+// an Out that is not backed by a console handle, uses the standard output.
+type Cursor struct {
+	Out io.Writer
 }
 
-func CursorDown(n int) {
-	cursorMove(0, -1*n)
+func (c *Cursor) handle() syscall.Handle {
+	if f, ok := c.Out.(interface {
+		Fd() uintptr
+	}); ok {
+		return syscall.Handle(f.Fd())
+	}
+	return syscall.Handle(os.Stdout.Fd())
 }
 
-func CursorForward(n int) {
-	cursorMove(n, 0)
+func (c *Cursor) Up(n int) {
+	c.move(0, n)
 }
 
-func CursorBack(n int) {
-	cursorMove(-1*n, 0)
+func (c *Cursor) Down(n int) {
+	c.move(0, -1*n)
 }
 
-func cursorMove(x int, y int) {
-	handle := syscall.Handle(os.Stdout.Fd())
+func (c *Cursor) Forward(n int) {
+	c.move(n, 0)
+}
+
+func (c *Cursor) Back(n int) {
+	c.move(-1*n, 0)
+}
+
+func (c *Cursor) move(x int, y int) {
+	handle := c.handle()
 
 	var csbi consoleScreenBufferInfo
 	procGetConsoleScreenBufferInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&csbi)))
@@ -35,18 +51,18 @@ func cursorMove(x int, y int) {
 	procSetConsoleCursorPosition.Call(uintptr(handle), uintptr(*(*int32)(unsafe.Pointer(&cursor))))
 }
 
-func CursorNextLine(n int) {
-	CursorUp(n)
-	CursorHorizontalAbsolute(0)
+func (c *Cursor) NextLine(n int) {
+	c.Up(n)
+	c.HorizontalAbsolute(0)
 }
 
-func CursorPreviousLine(n int) {
-	CursorDown(n)
-	CursorHorizontalAbsolute(0)
+func (c *Cursor) PreviousLine(n int) {
+	c.Down(n)
+	c.HorizontalAbsolute(0)
 }
 
-func CursorHorizontalAbsolute(x int) {
-	handle := syscall.Handle(os.Stdout.Fd())
+func (c *Cursor) HorizontalAbsolute(x int) {
+	handle := c.handle()
 
 	var csbi consoleScreenBufferInfo
 	procGetConsoleScreenBufferInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&csbi)))
@@ -62,8 +78,8 @@ func CursorHorizontalAbsolute(x int) {
 	procSetConsoleCursorPosition.Call(uintptr(handle), uintptr(*(*int32)(unsafe.Pointer(&cursor))))
 }
 
-func CursorShow() {
-	handle := syscall.Handle(os.Stdout.Fd())
+func (c *Cursor) Show() {
+	handle := c.handle()
 
 	var cci consoleCursorInfo
 	procGetConsoleCursorInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&cci)))
@@ -72,8 +88,8 @@ func CursorShow() {
 	procSetConsoleCursorInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&cci)))
 }
 
-func CursorHide() {
-	handle := syscall.Handle(os.Stdout.Fd())
+func (c *Cursor) Hide() {
+	handle := c.handle()
 
 	var cci consoleCursorInfo
 	procGetConsoleCursorInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&cci)))
@@ -82,6 +98,63 @@ func CursorHide() {
 	procSetConsoleCursorInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&cci)))
 }
 
+func (c *Cursor) EraseLine(mode EraseLineMode) {
+	handle := c.handle()
+
+	var csbi consoleScreenBufferInfo
+	procGetConsoleScreenBufferInfo.Call(uintptr(handle), uintptr(unsafe.Pointer(&csbi)))
+
+	var w uint32
+	var x Short
+	cursor := csbi.cursorPosition
+	switch mode {
+	case ERASE_LINE_END:
+		x = csbi.size.X
+	case ERASE_LINE_START:
+		x = 0
+	case ERASE_LINE_ALL:
+		cursor.X = 0
+		x = csbi.size.X
+	}
+	procFillConsoleOutputCharacter.Call(uintptr(handle), uintptr(' '), uintptr(x), uintptr(*(*int32)(unsafe.Pointer(&cursor))), uintptr(unsafe.Pointer(&w)))
+}
+
+func CursorUp(n int) {
+	(&Cursor{}).Up(n)
+}
+
+func CursorDown(n int) {
+	(&Cursor{}).Down(n)
+}
+
+func CursorForward(n int) {
+	(&Cursor{}).Forward(n)
+}
+
+func CursorBack(n int) {
+	(&Cursor{}).Back(n)
+}
+
+func CursorNextLine(n int) {
+	(&Cursor{}).NextLine(n)
+}
+
+func CursorPreviousLine(n int) {
+	(&Cursor{}).PreviousLine(n)
+}
+
+func CursorHorizontalAbsolute(x int) {
+	(&Cursor{}).HorizontalAbsolute(x)
+}
+
+func CursorShow() {
+	(&Cursor{}).Show()
+}
+
+func CursorHide() {
+	(&Cursor{}).Hide()
+}
+
 func CursorLocation() (Coord, error) {
 	handle := syscall.Handle(os.Stdout.Fd())
 
@@ -99,3 +172,13 @@ func Size() (Coord, error) {
 
 	return csbi.size, nil
 }
+
+// Width returns the number of columns of the console Out writes to, or 0 if Out is
+// not a console.
+func (c *Cursor) Width() int {
+	var csbi consoleScreenBufferInfo
+	if r, _, _ := procGetConsoleScreenBufferInfo.Call(uintptr(c.handle()), uintptr(unsafe.Pointer(&csbi))); r == 0 {
+		return 0
+	}
+	return int(csbi.window.right-csbi.window.left) + 1
+}
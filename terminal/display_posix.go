@@ -2,10 +2,6 @@
 
 package terminal
 
-import (
-	"fmt"
-)
-
 func EraseLine(mode EraseLineMode) {
-	fmt.Printf("\x1b[%dK", mode)
+	(&Cursor{}).EraseLine(mode)
 }
@@ -0,0 +1,144 @@
+package terminal
+
+import (
+	"unicode"
+)
+
+/*
+Format is a mask for the lines read with ReadFormattedLine. In it
+
+	9 stands for a digit
+	# stands for a digit that can be left out by typing the character after it
+	A stands for a letter
+	* stands for a letter or a digit
+	\ makes the character after it a literal
+
+and every other character is a literal, which is filled in on its own as the user types.
+For example "(999) 999-9999" for a phone number or "###.###.###.###" for an IP address.
+*/
+type Format string
+
+// formatSlot is a position of a format
+type formatSlot struct {
+	char    rune
+	literal bool
+}
+
+// slots returns the positions of the format
+func (f Format) slots() []formatSlot {
+	slots := []formatSlot{}
+	escaped := false
+	for _, char := range f {
+		switch {
+		case escaped:
+			slots = append(slots, formatSlot{char: char, literal: true})
+			escaped = false
+		case char == '\\':
+			escaped = true
+		case char == '9' || char == '#' || char == 'A' || char == '*':
+			slots = append(slots, formatSlot{char: char})
+		default:
+			slots = append(slots, formatSlot{char: char, literal: true})
+		}
+	}
+	return slots
+}
+
+// accepts returns true if the slot can hold the character
+func (s formatSlot) accepts(r rune) bool {
+	switch s.char {
+	case '9', '#':
+		return unicode.IsDigit(r)
+	case 'A':
+		return unicode.IsLetter(r)
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// insert returns what typing r at the given slot adds to the line, the literals filled in
+// on the way included, along with the slot of every character added. Nothing is added if
+// r doesn't fit.
+func (f Format) insert(slots []formatSlot, at int, r rune) ([]rune, []int) {
+	added := []rune{}
+	positions := []int{}
+	for i := at; i < len(slots); i++ {
+		slot := slots[i]
+		switch {
+		case slot.literal && slot.char == r, !slot.literal && slot.accepts(r):
+			return append(added, r), append(positions, i)
+		case slot.literal:
+			added = append(added, slot.char)
+			positions = append(positions, i)
+		// an optional digit is skipped in case r is the literal after it
+		case slot.char == '#':
+		default:
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+// apply types the line through the format, returning the formatted line along with the
+// slot of every character in it. It stops at the first character that doesn't fit.
+func (f Format) apply(line []rune) ([]rune, []int, bool) {
+	slots := f.slots()
+	formatted := []rune{}
+	positions := []int{}
+	next := 0
+	for _, r := range line {
+		added, at := f.insert(slots, next, r)
+		if len(added) == 0 {
+			return formatted, positions, false
+		}
+		formatted = append(formatted, added...)
+		positions = append(positions, at...)
+		next = at[len(at)-1] + 1
+	}
+	return formatted, positions, true
+}
+
+// Apply returns the line with the literals of the format filled in, so a value can be
+// given with or without them.
+func (f Format) Apply(line []rune) []rune {
+	formatted, _, _ := f.apply(line)
+	return formatted
+}
+
+// Complete returns true if the line fits the format and every slot that can't be left out
+// is filled in. Optional digits can only be left out at the end of a group, so the line
+// must reach the last literal that comes before a slot to fill.
+func (f Format) Complete(line []rune) bool {
+	_, positions, ok := f.apply(line)
+	if !ok {
+		return false
+	}
+
+	next := 0
+	if len(positions) > 0 {
+		next = positions[len(positions)-1] + 1
+	}
+	literal := false
+	for _, slot := range f.slots()[next:] {
+		switch {
+		case slot.literal:
+			literal = true
+		case slot.char != '#' || literal:
+			return false
+		}
+	}
+	return true
+}
+
+// Raw returns the characters of the line without the literals of the format.
+func (f Format) Raw(line []rune) []rune {
+	formatted, positions, _ := f.apply(line)
+	slots := f.slots()
+
+	raw := []rune{}
+	for i, position := range positions {
+		if !slots[position].literal {
+			raw = append(raw, formatted[i])
+		}
+	}
+	return raw
+}
@@ -66,6 +66,11 @@ func NewAnsiStderr() io.Writer {
 	return &Writer{out: out, handle: handle, orgAttr: csbi.attributes}
 }
 
+// Fd returns the console handle the writer converts escape sequences for.
+func (w *Writer) Fd() uintptr {
+	return uintptr(w.handle)
+}
+
 func (w *Writer) Write(data []byte) (n int, err error) {
 	r := bytes.NewReader(data)
 
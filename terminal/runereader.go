@@ -1,12 +1,15 @@
 package terminal
 
 import (
+	"fmt"
+	"io"
 	"os"
-	"unicode"
 )
 
 type RuneReader struct {
 	Input *os.File
+	// Output is where the line being edited is echoed, Stdout is used when it is nil
+	Output io.Writer
 
 	state runeReaderState
 }
@@ -18,8 +21,37 @@ func NewRuneReader(input *os.File) *RuneReader {
 	}
 }
 
+func (rr *RuneReader) output() io.Writer {
+	if rr.Output == nil {
+		return Stdout
+	}
+	return rr.Output
+}
+
+func (rr *RuneReader) print(a ...interface{}) {
+	fmt.Fprint(rr.output(), a...)
+}
+
+func (rr *RuneReader) printf(format string, a ...interface{}) {
+	fmt.Fprintf(rr.output(), format, a...)
+}
+
+func (rr *RuneReader) soundBell() {
+	rr.print("\a")
+}
+
+// cursor moves the cursor of the terminal the line is echoed on
+func (rr *RuneReader) cursor() *Cursor {
+	// without a specific output the cursor is left to the package default
+	if rr.Output == nil {
+		return &Cursor{}
+	}
+	return &Cursor{Out: rr.Output}
+}
+
 func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 	line := []rune{}
+	cursor := rr.cursor()
 
 	// we only care about horizontal displacements from the origin so start counting at 0
 	index := 0
@@ -34,7 +66,7 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 		// if the user pressed enter or some other newline/termination like ctrl+d
 		if r == '\r' || r == '\n' || r == KeyEndTransmission {
 			// go to the beginning of the next line
-			Print("\r\n")
+			rr.print("\r\n")
 
 			// we're done processing the input
 			return line, nil
@@ -43,7 +75,7 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 		// if the user interrupts (ie with ctrl+c)
 		if r == KeyInterrupt {
 			// go to the beginning of the next line
-			Print("\r\n")
+			rr.print("\r\n")
 
 			// we're done processing the input, and treat interrupt like an error
 			return line, InterruptErr
@@ -59,10 +91,10 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 					line = line[:len(line)-1]
 
 					// go back one
-					CursorBack(1)
+					cursor.Back(1)
 
 					// clear the rest of the line
-					EraseLine(ERASE_LINE_END)
+					cursor.EraseLine(ERASE_LINE_END)
 				} else {
 					// we need to remove a character from the middle of the word
 
@@ -70,23 +102,23 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 					line = append(line[:index-1], line[index:]...)
 
 					// go back one space so we can clear the rest
-					CursorBack(1)
+					cursor.Back(1)
 
 					// clear the rest of the line
-					EraseLine(ERASE_LINE_END)
+					cursor.EraseLine(ERASE_LINE_END)
 
 					// print what comes after
-					Print(string(line[index-1:]))
+					rr.print(string(line[index-1:]))
 
 					// leave the cursor where the user left it
-					CursorBack(len(line) - index + 1)
+					cursor.Back(len(line) - index + 1)
 				}
 
 				// decrement the index
 				index--
 			} else {
 				// otherwise the user pressed backspace while at the beginning of the line
-				soundBell()
+				rr.soundBell()
 			}
 
 			// we're done processing this key
@@ -98,14 +130,14 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 			// and we have space to the left
 			if index > 0 {
 				// move the cursor to the left
-				CursorBack(1)
+				cursor.Back(1)
 				// decrement the index
 				index--
 
 			} else {
 				// otherwise we are at the beginning of where we started reading lines
 				// sound the bell
-				soundBell()
+				rr.soundBell()
 			}
 
 			// we're done processing this key press
@@ -117,22 +149,22 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 			// and we have space to the right of the word
 			if index < len(line) {
 				// move the cursor to the right
-				CursorForward(1)
+				cursor.Forward(1)
 				// increment the index
 				index++
 
 			} else {
 				// otherwise we are at the end of the word and can't go past
 				// sound the bell
-				soundBell()
+				rr.soundBell()
 			}
 
 			// we're done processing this key press
 			continue
 		}
 
-		// if the letter is another escape sequence
-		if unicode.IsControl(r) {
+		// if the letter is another escape sequence or control key
+		if !IsPrintable(r) {
 			// ignore it
 			continue
 		}
@@ -149,35 +181,108 @@ func (rr *RuneReader) ReadLine(mask rune) ([]rune, error) {
 			// if we don't need to mask the input
 			if mask == 0 {
 				// just print the character the user pressed
-				Printf("%c", r)
+				rr.printf("%c", r)
 			} else {
 				// otherwise print the mask we were given
-				Printf("%c", mask)
+				rr.printf("%c", mask)
 			}
 		} else {
 			// we are in the middle of the word so we need to insert the character the user pressed
 			line = append(line[:index], append([]rune{r}, line[index:]...)...)
 
 			// visually insert the character by deleting the rest of the line
-			EraseLine(ERASE_LINE_END)
+			cursor.EraseLine(ERASE_LINE_END)
 
 			// print the rest of the word after
 			for _, char := range line[index:] {
 				// if we don't need to mask the input
 				if mask == 0 {
 					// just print the character the user pressed
-					Printf("%c", char)
+					rr.printf("%c", char)
 				} else {
 					// otherwise print the mask we were given
-					Printf("%c", mask)
+					rr.printf("%c", mask)
 				}
 			}
 
 			// leave the cursor where the user left it
-			CursorBack(len(line) - index - 1)
+			cursor.Back(len(line) - index - 1)
 
 			// accommodate the new letter in our counter
 			index++
 		}
 	}
 }
+
+// ReadFormattedLine is like ReadLine but only accepts the characters that fit the format
+// and fills in its literals on its own. Backspace removes the last character typed along
+// with the literals before it. The line is returned with the literals, see Format.Raw to
+// leave them out.
+func (rr *RuneReader) ReadFormattedLine(format Format) ([]rune, error) {
+	slots := format.slots()
+	line := []rune{}
+	// the slot of the format every character of the line is in
+	positions := []int{}
+	cursor := rr.cursor()
+
+	for {
+		// wait for some input
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return line, err
+		}
+
+		// if the user pressed enter or some other newline/termination like ctrl+d
+		if r == '\r' || r == '\n' || r == KeyEndTransmission {
+			// go to the beginning of the next line
+			rr.print("\r\n")
+			return line, nil
+		}
+
+		// if the user interrupts (ie with ctrl+c)
+		if r == KeyInterrupt {
+			// go to the beginning of the next line
+			rr.print("\r\n")
+			return line, InterruptErr
+		}
+
+		if r == KeyBackspace || r == KeyDelete {
+			if len(line) == 0 {
+				rr.soundBell()
+				continue
+			}
+
+			// remove the last character and the literals that were filled in before it
+			removed := 1
+			for removed < len(line) && slots[positions[len(line)-removed-1]].literal {
+				removed++
+			}
+			line = line[:len(line)-removed]
+			positions = positions[:len(positions)-removed]
+
+			cursor.Back(removed)
+			cursor.EraseLine(ERASE_LINE_END)
+			continue
+		}
+
+		// the line is only ever edited at its end
+		if !IsPrintable(r) {
+			continue
+		}
+
+		next := 0
+		if len(positions) > 0 {
+			next = positions[len(positions)-1] + 1
+		}
+		added, at := format.insert(slots, next, r)
+		if len(added) == 0 {
+			// the character doesn't fit the format
+			rr.soundBell()
+			continue
+		}
+
+		line = append(line, added...)
+		positions = append(positions, at...)
+		rr.print(string(added))
+	}
+}
@@ -65,6 +65,10 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 		if err != nil {
 			return r, size, err
 		}
+		// alt+enter is sent as an escape before the return
+		if r == '\r' || r == '\n' {
+			return KeyAltEnter, 1, nil
+		}
 		if r != '[' {
 			return r, size, fmt.Errorf("Unexpected Escape Sequence: %q", []rune{'\033', r})
 		}
@@ -81,6 +85,17 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 			return KeyArrowUp, 1, nil
 		case 'B':
 			return KeyArrowDown, 1, nil
+		case 'Z':
+			return KeyShiftTab, 1, nil
+		case '5', '6':
+			// page up and down are followed by a ~
+			if tilde, _, err := rr.state.buf.ReadRune(); err != nil || tilde != '~' {
+				return r, size, fmt.Errorf("Unknown Escape Sequence: %q", []rune{'\033', '[', r, tilde})
+			}
+			if r == '5' {
+				return KeyPageUp, 1, nil
+			}
+			return KeyPageDown, 1, nil
 		}
 		return r, size, fmt.Errorf("Unknown Escape Sequence: %q", []rune{'\033', '[', r})
 	}
@@ -16,15 +16,20 @@ var (
 const (
 	EVENT_KEY = 0x0001
 
-	// key codes for arrow keys
+	// key codes for arrow and page keys
 	// https://msdn.microsoft.com/en-us/library/windows/desktop/dd375731(v=vs.85).aspx
+	VK_PRIOR = 0x21
+	VK_NEXT  = 0x22
 	VK_LEFT  = 0x25
 	VK_UP    = 0x26
 	VK_RIGHT = 0x27
 	VK_DOWN  = 0x28
 
+	RIGHT_ALT_PRESSED  = 0x0001
+	LEFT_ALT_PRESSED   = 0x0002
 	RIGHT_CTRL_PRESSED = 0x0004
 	LEFT_CTRL_PRESSED  = 0x0008
+	SHIFT_PRESSED      = 0x0010
 
 	ENABLE_ECHO_INPUT      uint32 = 0x0004
 	ENABLE_LINE_INPUT      uint32 = 0x0002
@@ -106,6 +111,14 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 			return KeyInterrupt, bytesRead, nil
 		}
 
+		if key.wdControlKeyState&(LEFT_ALT_PRESSED|RIGHT_ALT_PRESSED) != 0 && key.unicodeChar == '\r' {
+			return KeyAltEnter, bytesRead, nil
+		}
+
+		if key.wdControlKeyState&SHIFT_PRESSED != 0 && key.unicodeChar == '\t' {
+			return KeyShiftTab, bytesRead, nil
+		}
+
 		// not a normal character so look up the input sequence from the
 		// virtual key code mappings (VK_*)
 		if key.unicodeChar == 0 {
@@ -118,6 +131,10 @@ func (rr *RuneReader) ReadRune() (rune, int, error) {
 				return KeyArrowRight, bytesRead, nil
 			case VK_UP:
 				return KeyArrowUp, bytesRead, nil
+			case VK_PRIOR:
+				return KeyPageUp, bytesRead, nil
+			case VK_NEXT:
+				return KeyPageDown, bytesRead, nil
 			default:
 				// not a virtual key that we care about so just continue on to
 				// the next input key
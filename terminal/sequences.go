@@ -1,5 +1,7 @@
 package terminal
 
+import "unicode"
+
 const (
 	KeyArrowLeft       = '\x02'
 	KeyArrowRight      = '\x06'
@@ -16,6 +18,17 @@ const (
 	KeyDeleteLine      = '\x18' // Ctrl+X
 )
 
-func soundBell() {
-	Print("\a")
+// The keys read as escape sequences with no control character of their own are given runes
+// of the Unicode private use area so they can't be taken for a key pressed with Ctrl.
+const (
+	KeyPageUp   = '\uE000'
+	KeyPageDown = '\uE001'
+	KeyAltEnter = '\uE002'
+	KeyShiftTab = '\uE003'
+)
+
+// IsPrintable returns true if r is a character typed rather than a control character or
+// one of the keys given a rune of the private use area.
+func IsPrintable(r rune) bool {
+	return r >= KeySpace && !unicode.IsControl(r) && !unicode.Is(unicode.Co, r)
 }
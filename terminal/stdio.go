@@ -0,0 +1,28 @@
+package terminal
+
+import (
+	"io"
+	"os"
+)
+
+// Stdio is the set of files a prompt reads its input from and writes its output to.
+// Any field left empty falls back to the process' standard streams.
+type Stdio struct {
+	In  *os.File
+	Out io.Writer
+	Err io.Writer
+}
+
+// WithDefaults returns a copy of the Stdio with the empty fields filled in.
+func (s Stdio) WithDefaults() Stdio {
+	if s.In == nil {
+		s.In = os.Stdin
+	}
+	if s.Out == nil {
+		s.Out = Stdout
+	}
+	if s.Err == nil {
+		s.Err = os.Stderr
+	}
+	return s
+}
@@ -36,8 +36,32 @@ var goodTable = []TestUtil.TestTableEntry{
 			Default: true,
 		}, &answer,
 	},
+	{
+		"instant, press 'y' without enter", &survey.Confirm{
+			Message: "yes:",
+			Instant: true,
+		}, &answer,
+	},
+	{
+		"no default, press enter then 'n'", &survey.Confirm{
+			Message:   "no:",
+			Instant:   true,
+			NoDefault: true,
+		}, &answer,
+	},
+}
+
+var badTable = []TestUtil.TestTableEntry{
+	{
+		"skip, press 's'", &survey.Confirm{
+			Message: "skip:",
+			Instant: true,
+			Skip:    "skip",
+		}, &answer,
+	},
 }
 
 func main() {
 	TestUtil.RunTable(goodTable)
+	TestUtil.RunErrorTable(badTable)
 }
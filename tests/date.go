@@ -0,0 +1,40 @@
+package main
+
+import (
+	"time"
+
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var value = time.Time{}
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"use arrows and page up/down", &survey.Date{Message: "Pick a date:"}, &value,
+	},
+	{
+		"starting on monday with a default", &survey.Date{
+			Message:   "Pick a date:",
+			Default:   time.Date(2018, time.March, 14, 0, 0, 0, 0, time.Local),
+			WeekStart: time.Monday,
+		}, &value,
+	},
+	{
+		"only the next two weeks", &survey.Date{
+			Message: "Pick a date:",
+			Min:     time.Now(),
+			Max:     time.Now().AddDate(0, 0, 14),
+		}, &value,
+	},
+	{
+		"with a time, tab to change it", &survey.Date{Message: "Pick a date:", WithTime: true}, &value,
+	},
+	{
+		"type a date in a custom layout", &survey.Date{Message: "Pick a date:", Layout: "02/01/2006"}, &value,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
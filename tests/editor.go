@@ -1,6 +1,8 @@
 package main
 
 import (
+	"fmt"
+
 	"gopkg.in/AlecAivazis/survey.v1"
 	"gopkg.in/AlecAivazis/survey.v1/tests/util"
 )
@@ -33,8 +35,35 @@ var goodTable = []TestUtil.TestTableEntry{
 			Default:       "Hello World",
 		}, &answer,
 	},
+	{
+		"should launch the editor with arguments on a markdown file", &survey.Editor{
+			Message:     "vim should open a file ending in .md in read only mode",
+			Command:     "vim -R",
+			FilePattern: "*.md",
+		}, &answer,
+	},
+	{
+		"should edit an existing file in place", &survey.Editor{
+			Message: "the file holds the text of this test, opened read only",
+			Command: "vim -R",
+			File:    "editor.go",
+		}, &answer,
+	},
+}
+
+var validatedTable = []TestUtil.TestTableEntry{
+	{
+		"should reopen on the refused text, write less than 10 characters first", &survey.Editor{
+			Message: "write a message, the comments are stripped",
+			Comment: "Lines starting with # are ignored.\nThe message needs 10 characters at least.",
+		}, &answer,
+	},
 }
 
 func main() {
 	TestUtil.RunTable(goodTable)
+	for _, entry := range validatedTable {
+		fmt.Println(entry.Name)
+		survey.AskOne(entry.Prompt, entry.Value, survey.MinLength(10))
+	}
 }
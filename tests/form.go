@@ -0,0 +1,45 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var login = struct {
+	Host     string
+	User     string
+	Password string
+	Remember bool
+	Region   string
+}{}
+
+var answers = map[string]interface{}{}
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"login, leave user empty to see the error", &survey.Form{
+			Message: "Log in",
+			Fields: []*survey.Question{
+				{Name: "host", Prompt: &survey.Input{Message: "Host:", Default: "localhost"}},
+				{Name: "user", Prompt: &survey.Input{Message: "User:"}, Validate: survey.Required},
+				{Name: "password", Prompt: &survey.Password{Message: "Password:"}, Validate: survey.Required},
+				{Name: "remember", Prompt: &survey.Confirm{Message: "Remember me?"}},
+			},
+			SubmitLabel: "Log in",
+		}, &login,
+	},
+	{
+		"into a map with a select", &survey.Form{
+			Message: "Deploy",
+			Fields: []*survey.Question{
+				{Name: "region", Prompt: &survey.Select{Message: "Region:", Options: []string{"us-east", "eu-west"}}},
+				{Name: "version", Prompt: &survey.Input{Message: "Version:"}, Transform: survey.ToLower},
+			},
+			Help: "Where and what to deploy",
+		}, &answers,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
@@ -1,12 +1,29 @@
 package main
 
 import (
+	"strings"
+
 	"gopkg.in/AlecAivazis/survey.v1"
 	"gopkg.in/AlecAivazis/survey.v1/tests/util"
 )
 
 var val = ""
 
+var months = []string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+func suggestMonths(toComplete string) []string {
+	suggestions := []string{}
+	for _, month := range months {
+		if strings.HasPrefix(strings.ToLower(month), strings.ToLower(toComplete)) {
+			suggestions = append(suggestions, month)
+		}
+	}
+	return suggestions
+}
+
 var table = []TestUtil.TestTableEntry{
 	{
 		"no default", &survey.Input{Message: "Hello world"}, &val,
@@ -20,6 +37,21 @@ var table = []TestUtil.TestTableEntry{
 	{
 		"input text in random location", &survey.Input{Message: "Hello"}, &val,
 	},
+	{
+		"suggestions, type 'ju' and press tab", &survey.Input{Message: "Pick a month:", Suggest: suggestMonths}, &val,
+	},
+	{
+		"suggestions, press tab to see a page of 4", &survey.Input{Message: "Pick a month:", Suggest: suggestMonths, PageSize: 4}, &val,
+	},
+	{
+		"phone number format", &survey.Input{Message: "Phone number:", Format: "(999) 999-9999"}, &val,
+	},
+	{
+		"ip address format, type '10.0.0.1'", &survey.Input{Message: "IP address:", Format: "###.###.###.###"}, &val,
+	},
+	{
+		"raw format", &survey.Input{Message: "Product code:", Format: "AAAA-9999", Raw: true}, &val,
+	},
 }
 
 func main() {
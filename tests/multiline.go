@@ -0,0 +1,31 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var value = ""
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"ctrl+d to submit", &survey.Multiline{Message: "Describe the change:"}, &value,
+	},
+	{
+		"alt+enter to submit", &survey.Multiline{Message: "Describe the change:", SubmitKey: terminal.KeyAltEnter}, &value,
+	},
+	{
+		"at most 3 lines", &survey.Multiline{Message: "Describe the change:", MaxLines: 3}, &value,
+	},
+	{
+		"edit a default, type a long line to see it wrap", &survey.Multiline{
+			Message: "Describe the change:",
+			Default: "first line\nsecond line",
+		}, &value,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
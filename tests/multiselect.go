@@ -42,6 +42,30 @@ var table = []TestUtil.TestTableEntry{
 			Default: []string{"Sundayaa"},
 		}, &answer,
 	},
+	{
+		"groups, the weekend days are grouped", &survey.MultiSelect{
+			Message: "What days do you prefer:",
+			Groups: []survey.OptionGroup{
+				{Header: "Weekdays", Options: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}},
+				{Header: "Weekend", Options: []string{"Saturday", "Sunday"}},
+			},
+		}, &answer,
+	},
+	{
+		"limits, pick between 2 and 4 (→ all, ← none, tab inverts)", &survey.MultiSelect{
+			Message: "Pick between 2 and 4 toppings:",
+			Options: []string{"cheese", "ham", "mushrooms", "olives", "onions", "peppers"},
+			Min:     2,
+			Max:     4,
+		}, &answer,
+	},
+	{
+		"other answers, space on Other… to type one", &survey.MultiSelect{
+			Message: "Pick your languages:",
+			Options: []string{"Go", "Rust", "Python"},
+			Other:   "Other…",
+		}, &answer,
+	},
 }
 
 func main() {
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"math"
+
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var intValue = 0
+var floatValue = 0.0
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"no range", &survey.Number{Message: "Pick a number:"}, &intValue,
+	},
+	{
+		"range and default, use arrows", &survey.Number{Message: "Pick a number:", Min: 1, Max: 10, Default: 5}, &intValue,
+	},
+	{
+		"open range, type 0 to see the error", &survey.Number{Message: "Pick a number:", Min: 1, Max: math.Inf(1)}, &intValue,
+	},
+	{
+		"float with step", &survey.Number{Message: "Pick a number:", Float: true, Step: 0.25}, &floatValue,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
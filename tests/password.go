@@ -17,6 +17,22 @@ var table = []TestUtil.TestTableEntry{
 	{
 		"no help, send '?'", &survey.Password{Message: "Please type your password:"}, &value,
 	},
+	{
+		"confirm, type a mismatch first", &survey.Password{Message: "Choose a password:", Confirm: true}, &value,
+	},
+	{
+		"strength meter and masked summary", &survey.Password{
+			Message:       "Choose a password:",
+			Strength:      survey.PasswordStrength,
+			MaskedSummary: true,
+		}, &value,
+	},
+	{
+		"custom mask", &survey.Password{Message: "Please type your password:", Mask: '•'}, &value,
+	},
+	{
+		"no echo", &survey.Password{Message: "Please type your password:", NoEcho: true}, &value,
+	},
 }
 
 func main() {
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var value = ""
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"tab to complete", &survey.Path{Message: "Pick a path:"}, &value,
+	},
+	{
+		"browse from the start", &survey.Path{Message: "Pick a path:", Browse: true}, &value,
+	},
+	{
+		"existing go files only", &survey.Path{
+			Message:   "Pick a file:",
+			OnlyFiles: true,
+			Patterns:  []string{"*.go"},
+			MustExist: true,
+			Browse:    true,
+		}, &value,
+	},
+	{
+		"directories, hidden ones too", &survey.Path{Message: "Pick a directory:", OnlyDirs: true, ShowHidden: true}, &value,
+	},
+	{
+		"relative to the parent directory", &survey.Path{Message: "Pick a path:", Root: ".."}, &value,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+func main() {
+	fmt.Println("spinner that succeeds")
+	spinner := &survey.Spinner{Message: "Working..."}
+	spinner.Start()
+	time.Sleep(time.Second)
+	spinner.SetMessage("Almost done...")
+	time.Sleep(time.Second)
+	spinner.Success("Done")
+
+	fmt.Println("spinner that fails")
+	spinner = &survey.Spinner{Message: "Working...", Frames: []string{"|", "/", "-", "\\"}}
+	spinner.Start()
+	time.Sleep(time.Second)
+	spinner.Fail("Something went wrong")
+
+	fmt.Println("progress bar updated by 4 workers")
+	bar := &survey.ProgressBar{Message: "Processing", Total: 40}
+	bar.Start()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				time.Sleep(50 * time.Millisecond)
+				bar.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	bar.Success("Processed every item")
+
+	name := ""
+	survey.AskOne(&survey.Input{Message: "The prompts still line up, what is your name?"}, &name, nil)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var values = []string{}
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"reorder", &survey.Rank{
+			Message: "Order the regions to fail over to:",
+			Options: []string{"us-east", "us-west", "eu-west", "ap-south"},
+		}, &values,
+	},
+	{
+		"default order", &survey.Rank{
+			Message: "Order the regions to fail over to:",
+			Options: []string{"us-east", "us-west", "eu-west", "ap-south"},
+			Default: []string{"eu-west", "us-east"},
+		}, &values,
+	},
+	{
+		"paged", &survey.Rank{
+			Message:  "Order the days:",
+			Options:  []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+			PageSize: 4,
+		}, &values,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var score = 0
+var label = ""
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"stars", &survey.Rating{Message: "Rate the talk:", Stars: true, Default: 3}, &score,
+	},
+	{
+		"nps, press enter to see the error", &survey.Rating{Message: "How likely are you to recommend us?", Min: 0, Max: 10}, &score,
+	},
+	{
+		"likert", &survey.Rating{
+			Message: "The release process is easy to follow",
+			Labels:  []string{"Strongly disagree", "Disagree", "Neutral", "Agree", "Strongly agree"},
+			Help:    "Pick how much you agree",
+		}, &label,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
@@ -1,6 +1,13 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
 	"gopkg.in/AlecAivazis/survey.v1"
 	"gopkg.in/AlecAivazis/survey.v1/tests/util"
 )
@@ -57,6 +64,105 @@ var goodTable = []TestUtil.TestTableEntry{
 			Options: []string{"red", "blue", "green"},
 		}, &answer,
 	},
+	{
+		"groups, headers are skipped and filtering 'cl' hides the first group", &survey.Select{
+			Message: "Choose a project:",
+			Options: []string{"new project"},
+			Groups: []survey.OptionGroup{
+				{Header: "Recently used", Options: []string{"api", "web"}},
+				{Header: "All projects", Options: []string{"api", "cli", "docs", "web"}},
+			},
+			PageSize: 4,
+		}, &answer,
+	},
+	{
+		"descriptions beside the options", &survey.Select{
+			Message:     "Choose a color:",
+			Options:     []string{"red", "blue", "green"},
+			Description: describeColor,
+		}, &answer,
+	},
+	{
+		"description below the list with a preview", &survey.Select{
+			Message:          "Choose a color:",
+			Options:          []string{"red", "blue", "green"},
+			Description:      describeColor,
+			DescriptionBelow: true,
+			Preview: func(color string) string {
+				return strings.Repeat(color+"\n", 12)
+			},
+		}, &answer,
+	},
+	{
+		"fuzzy filtering, type 'gthb'", &survey.Select{
+			Message: "Choose a repository:",
+			Options: []string{"gadgetly", "git-log", "github", "gitlab", "homebrew"},
+		}, &answer,
+	},
+	{
+		"contains filtering, 'gthb' matches nothing", &survey.Select{
+			Message: "Choose a repository:",
+			Options: []string{"gadgetly", "git-log", "github", "gitlab", "homebrew"},
+			Filter:  survey.ContainsFilter,
+		}, &answer,
+	},
+	{
+		"options loaded over a few seconds", &survey.Select{
+			Message: "Choose a file:",
+			Source:  survey.ReaderSource(slowFiles()),
+		}, &answer,
+	},
+	{
+		"search a slow catalog, 'error' fails", &survey.Select{
+			Message: "Choose a package:",
+			Search:  searchPackages,
+		}, &answer,
+	},
+	{
+		"other answer, type one in place of the options", &survey.Select{
+			Message: "How did you hear about us?",
+			Options: []string{"A friend", "A search engine", "A podcast"},
+			Other:   "Other…",
+		}, &answer,
+	},
+}
+
+// searchPackages finds the packages holding the query after a second
+func searchPackages(ctx context.Context, query string, add func(options ...string)) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Second):
+	}
+	if query == "error" {
+		return errors.New("the registry is down")
+	}
+
+	for _, name := range []string{"survey", "cobra", "viper", "testify", "color"} {
+		if strings.Contains(name, query) {
+			add(name)
+		}
+	}
+	return nil
+}
+
+// slowFiles returns a reader listing many files a batch at a time
+func slowFiles() io.Reader {
+	reader, writer := io.Pipe()
+	go func() {
+		for batch := 0; batch < 20; batch++ {
+			for i := 0; i < 5000; i++ {
+				fmt.Fprintf(writer, "src/package-%d/file-%d.go\n", batch, i)
+			}
+			time.Sleep(200 * time.Millisecond)
+		}
+		writer.Close()
+	}()
+	return reader
+}
+
+func describeColor(color string) string {
+	return map[string]string{"red": "warm", "blue": "cold"}[color]
 }
 
 var badTable = []TestUtil.TestTableEntry{
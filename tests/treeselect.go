@@ -0,0 +1,52 @@
+package main
+
+import (
+	"gopkg.in/AlecAivazis/survey.v1"
+	"gopkg.in/AlecAivazis/survey.v1/tests/util"
+)
+
+var value = ""
+var values = []string{}
+
+// regions returns a fresh tree for every prompt so expanding one doesn't affect the others
+func regions() []*survey.TreeNode {
+	return []*survey.TreeNode{
+		{Value: "emea", Children: []*survey.TreeNode{
+			{Value: "eu-west", Children: []*survey.TreeNode{{Value: "a"}, {Value: "b"}}},
+			{Value: "eu-north", Children: []*survey.TreeNode{{Value: "a"}}},
+		}},
+		{Value: "amer", Children: []*survey.TreeNode{
+			{Value: "us-east", Children: []*survey.TreeNode{{Value: "a"}, {Value: "b"}, {Value: "c"}}},
+			{Value: "us-west", Children: []*survey.TreeNode{{Value: "a"}}},
+		}},
+	}
+}
+
+var table = []TestUtil.TestTableEntry{
+	{
+		"pick one", &survey.TreeSelect{Message: "Pick a zone:", Options: regions()}, &value,
+	},
+	{
+		"default highlighted", &survey.TreeSelect{
+			Message: "Pick a zone:",
+			Options: regions(),
+			Default: []string{"amer/us-east/b"},
+		}, &value,
+	},
+	{
+		"check many", &survey.TreeSelect{Message: "Pick zones:", Options: regions(), Multi: true}, &values,
+	},
+	{
+		"defaults checked", &survey.TreeSelect{
+			Message:   "Pick zones:",
+			Options:   regions(),
+			Multi:     true,
+			Default:   []string{"emea::eu-west", "amer::us-east::a"},
+			Separator: "::",
+		}, &values,
+	},
+}
+
+func main() {
+	TestUtil.RunTable(table)
+}
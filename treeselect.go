@@ -0,0 +1,517 @@
+package survey
+
+import (
+	"errors"
+	"strings"
+
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+// TreeNode is an option of a TreeSelect along with the options nested under it.
+type TreeNode struct {
+	Value    string
+	Children []*TreeNode
+	// Expanded shows the children of the node when the prompt starts
+	Expanded bool
+}
+
+/*
+TreeSelect is a prompt that presents options nested in a tree. The right arrow expands
+the highlighted option and the left arrow collapses it, or goes to its parent. Typing
+filters the options, keeping the ones above a match so it is shown where it belongs.
+Options are answered with their path, the values from the top of the tree down to the
+option joined by Separator ("/" by default). Response type is a string, or a slice of
+strings in Multi mode where space checks an option and everything under it and the
+answer is the path of every checked option at the bottom of the tree.
+
+	service := ""
+	prompt := &survey.TreeSelect{
+		Message: "Choose a service:",
+		Options: []*survey.TreeNode{
+			{Value: "payments", Children: []*survey.TreeNode{{Value: "api"}, {Value: "worker"}}},
+			{Value: "search", Children: []*survey.TreeNode{{Value: "indexer"}}},
+		},
+	}
+	survey.AskOne(prompt, &service, nil)
+*/
+type TreeSelect struct {
+	core.Renderer
+	Message       string
+	Options       []*TreeNode
+	Multi         bool
+	Default       []string
+	Separator     string
+	Help          string
+	PageSize      int
+	VimMode       bool
+	FilterMessage string
+	Template      string
+	TemplateFuncs map[string]interface{}
+	filter        string
+	selectedIndex int
+	expanded      map[*TreeNode]bool
+	checked       map[*TreeNode]bool
+	showingHelp   bool
+}
+
+// TreeRow is a node of a TreeSelect as it is shown in the list.
+type TreeRow struct {
+	*TreeNode
+	Path     string
+	Depth    int
+	Indent   string
+	Expanded bool
+	Checked  bool
+	Partial  bool
+	parent   *TreeRow
+}
+
+// the data available to the templates when processing
+type TreeSelectTemplateData struct {
+	TreeSelect
+	PageEntries   []*TreeRow
+	SelectedIndex int
+	Answer        string
+	ShowAnswer    bool
+	ShowHelp      bool
+}
+
+var TreeSelectQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+{{- color "default+hb"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- if .ShowAnswer}}{{color "cyan"}} {{.Answer}}{{color "reset"}}{{"\n"}}
+{{- else}}
+  {{- "  "}}{{- color "cyan"}}[Use arrows to move and expand
+  {{- if .Multi}}, space to select{{end}}, type to filter
+  {{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}]{{color "reset"}}
+  {{- "\n"}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- if eq $ix $.SelectedIndex}}{{color "cyan"}}{{ SelectFocusIcon }}{{color "reset"}}{{else}} {{end}}
+    {{- " "}}{{$row.Indent}}{{if $row.Children}}{{if $row.Expanded}}▾{{else}}▸{{end}}{{else}} {{end}}
+    {{- if $.Multi}}
+      {{- if $row.Checked}}{{color "green"}} {{ MarkedOptionIcon }}
+      {{- else if $row.Partial}}{{color "yellow"}} {{ PartialOptionIcon }}
+      {{- else}}{{color "default+hb"}} {{ UnmarkedOptionIcon }}{{end}}
+      {{- color "reset"}}
+    {{- end}}
+    {{- " "}}{{$row.Value}}{{"\n"}}
+  {{- end}}
+{{- end}}`
+
+// TreeSelectAccessibleQuestionTemplate is used instead of TreeSelectQuestionTemplate when
+// core.Accessible is set. The whole tree is only printed the first time the question is
+// asked and the user picks options by typing their numbers or paths.
+var TreeSelectAccessibleQuestionTemplate = `
+{{- if .ShowHelp }}{{- color "cyan"}}{{ HelpIcon }} {{ .Help }}{{color "reset"}}{{"\n"}}{{end}}
+{{- if .PageEntries}}
+  {{- color "green+hb"}}{{ QuestionIcon }} {{color "reset"}}
+  {{- color "default+hb"}}{{ .Message }}{{color "reset"}}{{"\n"}}
+  {{- range $ix, $row := .PageEntries}}
+    {{- "  "}}{{ inc $ix }}. {{$row.Path}}{{"\n"}}
+  {{- end}}
+{{- end}}
+{{- color "cyan"}}Type {{if .Multi}}numbers or paths separated by commas{{else}}a number or path{{end}}
+{{- if .Default}} (default: {{range $ix, $path := .Default}}{{if $ix}}, {{end}}{{$path}}{{end}}){{end}}
+{{- if and .Help (not .ShowHelp)}}, {{ HelpInputRune }} for more help{{end}}:{{color "reset"}} `
+
+// separator returns the string joining the values of a path
+func (t *TreeSelect) separator() string {
+	if t.Separator == "" {
+		return "/"
+	}
+	return t.Separator
+}
+
+// rows returns the nodes that are shown, or every node when all is set
+func (t *TreeSelect) rows(all bool) []*TreeRow {
+	filter := strings.ToLower(t.filter)
+	if all {
+		filter = ""
+	}
+	rows := []*TreeRow{}
+
+	var walk func(nodes []*TreeNode, parent *TreeRow, filter string)
+	walk = func(nodes []*TreeNode, parent *TreeRow, filter string) {
+		for _, node := range nodes {
+			// only keep the nodes that match the filter and the ones leading to them
+			if filter != "" && !treeMatches(node, filter) {
+				continue
+			}
+
+			row := &TreeRow{TreeNode: node, Path: node.Value, Expanded: t.expanded[node], parent: parent}
+			if parent != nil {
+				row.Path = parent.Path + t.separator() + node.Value
+				row.Depth = parent.Depth + 1
+			}
+			row.Indent = strings.Repeat("  ", row.Depth)
+			row.Checked, row.Partial = t.state(node)
+			rows = append(rows, row)
+
+			childFilter := filter
+			if strings.Contains(strings.ToLower(node.Value), filter) {
+				// under a match everything is shown as usual
+				childFilter = ""
+			} else {
+				// the nodes leading to a match are opened to show it
+				row.Expanded = true
+			}
+
+			if all || row.Expanded {
+				walk(node.Children, row, childFilter)
+			}
+		}
+	}
+	walk(t.Options, nil, filter)
+
+	return rows
+}
+
+// treeMatches returns true if the node or one of the nodes under it contains the filter
+func treeMatches(node *TreeNode, filter string) bool {
+	if strings.Contains(strings.ToLower(node.Value), filter) {
+		return true
+	}
+	for _, child := range node.Children {
+		if treeMatches(child, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// state returns whether every leaf under the node is checked, or only some of them
+func (t *TreeSelect) state(node *TreeNode) (checked bool, partial bool) {
+	if len(node.Children) == 0 {
+		return t.checked[node], false
+	}
+
+	all, some := true, false
+	for _, child := range node.Children {
+		childChecked, childPartial := t.state(child)
+		all = all && childChecked
+		some = some || childChecked || childPartial
+	}
+	return all, some && !all
+}
+
+// check checks or unchecks every leaf under the node
+func (t *TreeSelect) check(node *TreeNode, checked bool) {
+	if len(node.Children) == 0 {
+		t.checked[node] = checked
+		return
+	}
+	for _, child := range node.Children {
+		t.check(child, checked)
+	}
+}
+
+// OnChange is called on every keypress.
+func (t *TreeSelect) OnChange(key rune) {
+	rows := t.rows(false)
+	oldFilter := t.filter
+
+	switch {
+	case key == terminal.KeyArrowUp || (t.VimMode && key == 'k'):
+		// if we are at the top of the list go to the bottom
+		if len(rows) == 0 {
+			// there is nothing to move to while no row matches the filter
+		} else if t.selectedIndex == 0 {
+			t.selectedIndex = len(rows) - 1
+		} else {
+			t.selectedIndex--
+		}
+	case key == terminal.KeyArrowDown || (t.VimMode && key == 'j'):
+		// if we are at the bottom of the list go to the top
+		if len(rows) == 0 {
+			// there is nothing to move to while no row matches the filter
+		} else if t.selectedIndex == len(rows)-1 {
+			t.selectedIndex = 0
+		} else {
+			t.selectedIndex++
+		}
+	case key == terminal.KeyArrowRight || (t.VimMode && key == 'l'):
+		if t.selectedIndex < len(rows) && len(rows[t.selectedIndex].Children) > 0 {
+			t.expanded[rows[t.selectedIndex].TreeNode] = true
+		}
+	case key == terminal.KeyArrowLeft || (t.VimMode && key == 'h'):
+		if t.selectedIndex < len(rows) {
+			row := rows[t.selectedIndex]
+			if t.expanded[row.TreeNode] {
+				t.expanded[row.TreeNode] = false
+			} else if row.parent != nil {
+				// go up to the parent
+				for i, other := range rows {
+					if other == row.parent {
+						t.selectedIndex = i
+					}
+				}
+			}
+		}
+	case key == terminal.KeySpace && t.Multi:
+		if t.selectedIndex < len(rows) {
+			row := rows[t.selectedIndex]
+			t.check(row.TreeNode, !row.Checked)
+		}
+	case key == t.Config().HelpInputRune && t.Help != "":
+		t.showingHelp = true
+	case key == terminal.KeyEscape:
+		t.VimMode = !t.VimMode
+	case key == terminal.KeyDeleteWord || key == terminal.KeyDeleteLine:
+		t.filter = ""
+	case key == terminal.KeyDelete || key == terminal.KeyBackspace:
+		if t.filter != "" {
+			t.filter = t.filter[0 : len(t.filter)-1]
+		}
+	case terminal.IsPrintable(key):
+		t.filter += string(key)
+		t.VimMode = false
+	}
+
+	t.FilterMessage = ""
+	if t.filter != "" {
+		t.FilterMessage = " " + t.filter
+	}
+	if oldFilter != t.filter {
+		// filter changed
+		rows = t.rows(false)
+		t.selectedIndex = clampIndex(t.selectedIndex, len(rows))
+	}
+}
+
+// find returns the node at the given path
+func (t *TreeSelect) find(path string) *TreeNode {
+	for _, row := range t.rows(true) {
+		if row.Path == path {
+			return row.TreeNode
+		}
+	}
+	return nil
+}
+
+// checkedPaths returns the paths of the checked leaves in the order of the tree.
+func (t *TreeSelect) checkedPaths() []string {
+	answers := []string{}
+	for _, row := range t.rows(true) {
+		if len(row.Children) == 0 && t.checked[row.TreeNode] {
+			answers = append(answers, row.Path)
+		}
+	}
+	return answers
+}
+
+func (t *TreeSelect) Prompt() (interface{}, error) {
+	// if there are no options to render
+	if len(t.Options) == 0 {
+		// we failed
+		return "", errors.New("please provide options to select from")
+	}
+
+	t.filter = ""
+	t.FilterMessage = ""
+	t.selectedIndex = 0
+	t.showingHelp = false
+	t.expanded = map[*TreeNode]bool{}
+	t.checked = map[*TreeNode]bool{}
+	for _, row := range t.rows(true) {
+		t.expanded[row.TreeNode] = row.TreeNode.Expanded
+	}
+
+	// start with the defaults checked, or highlighted
+	for _, path := range t.Default {
+		node := t.find(path)
+		if node == nil {
+			continue
+		}
+		if t.Multi {
+			t.check(node, true)
+			continue
+		}
+
+		// open up the tree to the default
+		for _, row := range t.rows(true) {
+			if strings.HasPrefix(path, row.Path+t.separator()) {
+				t.expanded[row.TreeNode] = true
+			}
+		}
+		for i, row := range t.rows(false) {
+			if row.TreeNode == node {
+				t.selectedIndex = i
+			}
+		}
+		break
+	}
+
+	// accessible prompts are answered by typing instead of moving around the tree
+	if t.Config().Accessible {
+		return t.accessiblePrompt()
+	}
+
+	// hide the cursor
+	t.NewCursor().Hide()
+	// show the cursor when we're done
+	defer t.NewCursor().Show()
+
+	err := t.render(t.questionData())
+	if err != nil {
+		return "", err
+	}
+
+	rr := t.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	// start waiting for input
+	for {
+		r, _, err := rr.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		if r == '\r' || r == '\n' || r == terminal.KeyEndTransmission {
+			break
+		}
+		if r == terminal.KeyInterrupt {
+			return "", terminal.InterruptErr
+		}
+		t.OnChange(r)
+
+		err = t.render(t.questionData())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	rows := t.rows(false)
+	t.filter = ""
+	t.FilterMessage = ""
+
+	if t.Multi {
+		return t.checkedPaths(), nil
+	}
+	if t.selectedIndex < len(rows) {
+		return rows[t.selectedIndex].Path, nil
+	}
+	// nothing matched the filter
+	return "", errors.New("no option matches the filter")
+}
+
+// accessiblePrompt prints every node of the tree once and waits for the user to type the
+// numbers or paths of their choices, without ever redrawing the prompt.
+func (t *TreeSelect) accessiblePrompt() (interface{}, error) {
+	all := t.rows(true)
+	paths := make([]string, len(all))
+	for i, row := range all {
+		paths[i] = row.Path
+	}
+
+	// print the question along with every node
+	err := t.RenderWithFuncs(
+		TreeSelectAccessibleQuestionTemplate,
+		t.TemplateFuncs,
+		TreeSelectTemplateData{TreeSelect: *t, PageEntries: all},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	rr := t.NewRuneReader()
+	rr.SetTermMode()
+	defer rr.RestoreTermMode()
+
+	for {
+		line, err := rr.ReadLine(0)
+		if err != nil {
+			return "", err
+		}
+		val := strings.TrimSpace(string(line))
+		showHelp := false
+
+		switch {
+		// an empty response keeps the defaults
+		case val == "" && t.Multi:
+			return t.checkedPaths(), nil
+		case val == "" && len(t.Default) > 0:
+			return t.Default[0], nil
+		case val == string(t.Config().HelpInputRune) && t.Help != "":
+			showHelp = true
+		case t.Multi:
+			choices, err := accessibleChoices(paths, val)
+			if err == nil {
+				// replace the defaults with what the user typed
+				t.checked = map[*TreeNode]bool{}
+				for _, choice := range choices {
+					t.check(t.find(choice), true)
+				}
+				return t.checkedPaths(), nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := t.Error(err); err != nil {
+				return "", err
+			}
+		default:
+			choice, err := accessibleChoice(paths, val)
+			if err == nil {
+				return choice, nil
+			}
+			// we didn't get a valid answer, so print error and prompt again
+			if err := t.Error(err); err != nil {
+				return "", err
+			}
+		}
+
+		// ask again without repeating the tree
+		err = t.RenderWithFuncs(
+			TreeSelectAccessibleQuestionTemplate,
+			t.TemplateFuncs,
+			TreeSelectTemplateData{TreeSelect: *t, ShowHelp: showHelp},
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+func (t *TreeSelect) Cleanup(val interface{}) error {
+	answer, ok := val.(string)
+	if !ok {
+		answer = strings.Join(val.([]string), ", ")
+	}
+
+	return t.render(
+		TreeSelectTemplateData{
+			TreeSelect: *t,
+			Answer:     answer,
+			ShowAnswer: true,
+		},
+	)
+}
+
+// questionData returns the data to render the visible page of the tree
+func (t *TreeSelect) questionData() TreeSelectTemplateData {
+	rows := t.rows(false)
+
+	paths := make([]string, len(rows))
+	for i, row := range rows {
+		paths[i] = row.Path
+	}
+	page, idx := paginate(pageSize(t.PageSize, t.Config()), paths, t.selectedIndex)
+	start := t.selectedIndex - idx
+
+	return TreeSelectTemplateData{
+		TreeSelect:    *t,
+		PageEntries:   rows[start : start+len(page)],
+		SelectedIndex: idx,
+		ShowHelp:      t.showingHelp,
+	}
+}
+
+// render draws the prompt with its own Template and TemplateFuncs when they are set
+// instead of TreeSelectQuestionTemplate.
+func (t *TreeSelect) render(data TreeSelectTemplateData) error {
+	tmpl := TreeSelectQuestionTemplate
+	if t.Template != "" {
+		tmpl = t.Template
+	}
+	return t.RenderWithFuncs(tmpl, t.TemplateFuncs, data)
+}
@@ -0,0 +1,149 @@
+package survey
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/AlecAivazis/survey.v1/core"
+	"gopkg.in/AlecAivazis/survey.v1/terminal"
+)
+
+func init() {
+	// disable color output for all prompts to simplify testing
+	core.DisableColor = true
+}
+
+// regions returns a fresh tree of regions and zones to select from
+func regions() []*TreeNode {
+	return []*TreeNode{
+		{Value: "emea", Children: []*TreeNode{
+			{Value: "eu-west", Children: []*TreeNode{{Value: "a"}, {Value: "b"}}},
+			{Value: "eu-north", Children: []*TreeNode{{Value: "a"}}},
+		}},
+		{Value: "amer", Children: []*TreeNode{
+			{Value: "us-east", Children: []*TreeNode{{Value: "a"}}},
+		}},
+	}
+}
+
+// newTreeSelect returns a prompt ready to take keys without asking it
+func newTreeSelect(prompt *TreeSelect) *TreeSelect {
+	prompt.expanded = map[*TreeNode]bool{}
+	prompt.checked = map[*TreeNode]bool{}
+	return prompt
+}
+
+// rowPaths returns the paths of the rows that are shown
+func rowPaths(prompt *TreeSelect) []string {
+	paths := []string{}
+	for _, row := range prompt.rows(false) {
+		paths = append(paths, row.Path)
+	}
+	return paths
+}
+
+func TestTreeSelectRender(t *testing.T) {
+	prompt := newTreeSelect(&TreeSelect{Message: "Pick a zone:", Options: regions(), Multi: true})
+	prompt.expanded[prompt.Options[0]] = true
+	prompt.check(prompt.Options[0].Children[0], true)
+
+	tests := []struct {
+		title    string
+		data     TreeSelectTemplateData
+		expected string
+	}{
+		{
+			"Test TreeSelect question output",
+			prompt.questionData(),
+			`? Pick a zone:  [Use arrows to move and expand, space to select, type to filter]
+❯ ▾ ◐ emea
+    ▸ ◉ eu-west
+    ▸ ◯ eu-north
+  ▸ ◯ amer
+`,
+		},
+		{
+			"Test TreeSelect answer output",
+			TreeSelectTemplateData{TreeSelect: *prompt, Answer: "emea/eu-west/a, emea/eu-west/b", ShowAnswer: true},
+			"? Pick a zone: emea/eu-west/a, emea/eu-west/b\n",
+		},
+	}
+
+	outputBuffer := bytes.NewBufferString("")
+	terminal.Stdout = outputBuffer
+
+	for _, test := range tests {
+		outputBuffer.Reset()
+		err := prompt.Render(
+			TreeSelectQuestionTemplate,
+			test.data,
+		)
+		assert.Nil(t, err, test.title)
+		assert.Equal(t, test.expected, outputBuffer.String(), test.title)
+	}
+}
+
+func TestTreeSelect_expandAndCollapse(t *testing.T) {
+	prompt := newTreeSelect(&TreeSelect{Options: regions()})
+	assert.Equal(t, []string{"emea", "amer"}, rowPaths(prompt))
+
+	prompt.OnChange(terminal.KeyArrowRight)
+	assert.Equal(t, []string{"emea", "emea/eu-west", "emea/eu-north", "amer"}, rowPaths(prompt))
+
+	// left on a child goes up to its parent, then collapses it
+	prompt.OnChange(terminal.KeyArrowDown)
+	prompt.OnChange(terminal.KeyArrowLeft)
+	assert.Equal(t, 0, prompt.selectedIndex)
+	prompt.OnChange(terminal.KeyArrowLeft)
+	assert.Equal(t, []string{"emea", "amer"}, rowPaths(prompt))
+}
+
+func TestTreeSelect_filterKeepsAncestors(t *testing.T) {
+	prompt := newTreeSelect(&TreeSelect{Options: regions()})
+
+	for _, key := range "north" {
+		prompt.OnChange(key)
+	}
+	// the match is shown as usual, collapsed
+	assert.Equal(t, []string{"emea", "emea/eu-north"}, rowPaths(prompt))
+
+	prompt.OnChange(terminal.KeyDeleteLine)
+	for _, key := range "us-" {
+		prompt.OnChange(key)
+	}
+	assert.Equal(t, []string{"amer", "amer/us-east"}, rowPaths(prompt))
+}
+
+func TestTreeSelect_moveWithoutMatches(t *testing.T) {
+	prompt := newTreeSelect(&TreeSelect{Options: regions()})
+
+	// there is nothing to move to while no row matches
+	prompt.OnChange('z')
+	prompt.OnChange(terminal.KeyArrowUp)
+	assert.Equal(t, 0, prompt.selectedIndex)
+
+	prompt.OnChange(terminal.KeyBackspace)
+	prompt.OnChange(terminal.KeyArrowRight)
+	assert.Equal(t, []string{"emea", "emea/eu-west", "emea/eu-north", "amer"}, rowPaths(prompt))
+}
+
+func TestTreeSelect_checkingAParentChecksItsChildren(t *testing.T) {
+	prompt := newTreeSelect(&TreeSelect{Options: regions(), Multi: true})
+
+	prompt.OnChange(terminal.KeySpace)
+	assert.Equal(t, []string{"emea/eu-west/a", "emea/eu-west/b", "emea/eu-north/a"}, prompt.checkedPaths())
+
+	// unchecking a child leaves the parent partly checked
+	prompt.check(prompt.Options[0].Children[1].Children[0], false)
+	checked, partial := prompt.state(prompt.Options[0])
+	assert.False(t, checked)
+	assert.True(t, partial)
+
+	// a partly checked parent is checked completely
+	prompt.OnChange(terminal.KeySpace)
+	assert.Equal(t, []string{"emea/eu-west/a", "emea/eu-west/b", "emea/eu-north/a"}, prompt.checkedPaths())
+
+	prompt.OnChange(terminal.KeySpace)
+	assert.Equal(t, []string{}, prompt.checkedPaths())
+}